@@ -0,0 +1,256 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/userpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeUserServer: alice/pw 로그인, refresh token은 "r1" → "r2" 한 번만 갱신됨
+type fakeUserServer struct {
+	userpb.UnimplementedUserServiceServer
+}
+
+func (fakeUserServer) Login(ctx context.Context, req *userpb.LoginRequest) (*userpb.LoginResponse, error) {
+	if req.Username != "alice" || req.Password != "pw" {
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+	return &userpb.LoginResponse{AccessToken: "t1", RefreshToken: "r1", User: &userpb.User{Username: "alice"}}, nil
+}
+
+func (fakeUserServer) RefreshToken(ctx context.Context, req *userpb.RefreshTokenRequest) (*userpb.RefreshTokenResponse, error) {
+	if req.RefreshToken != "r1" {
+		return nil, status.Error(codes.Unauthenticated, "refresh token reused")
+	}
+	return &userpb.RefreshTokenResponse{AccessToken: "t2", RefreshToken: "r2"}, nil
+}
+
+func (fakeUserServer) Logout(ctx context.Context, req *userpb.LogoutRequest) (*userpb.LogoutResponse, error) {
+	return &userpb.LogoutResponse{}, nil
+}
+
+// fakeChatServer: 방 하나(room-1)만 있음
+// access token "t1"은 만료된 것으로 취급해서 GetMyRooms가 한 번 갱신하게 만들고,
+// JoinChat은 첫 연결에서 기록 두 개를 보내고 끊은 뒤, 두 번째 연결에서는 보낸 메시지를 ack + 브로드캐스트함
+type fakeChatServer struct {
+	chatpb.UnimplementedChatServiceServer
+
+	mu      sync.Mutex
+	joins   int
+	resumes []string // 입장할 때마다 받은 resume_cursor
+}
+
+func bearer(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get("authorization"); len(v) > 0 {
+		return strings.TrimPrefix(v[0], "Bearer ")
+	}
+	return ""
+}
+
+func (s *fakeChatServer) GetMyRooms(ctx context.Context, req *chatpb.GetMyRoomsRequest) (*chatpb.GetMyRoomsResponse, error) {
+	if bearer(ctx) != "t2" {
+		return nil, status.Error(codes.Unauthenticated, "token expired")
+	}
+	return &chatpb.GetMyRoomsResponse{Rooms: []*chatpb.ChatRoomInfo{{
+		RoomId: "room-1", OtherUserId: "bob", OtherUserNickname: "Bob", UnreadCount: 2,
+		LastMessageId: "m2", LastMessageUsername: "bob", LastMessagePreview: "two",
+		LastMessageAt: timestamppb.New(time.Date(2024, 5, 1, 9, 1, 0, 0, time.UTC)),
+	}}}, nil
+}
+
+func (s *fakeChatServer) GetRoomID(ctx context.Context, req *chatpb.GetRoomIDRequest) (*chatpb.GetRoomIDResponse, error) {
+	if req.OtherId != "bob" {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return &chatpb.GetRoomIDResponse{RoomId: "room-1"}, nil
+}
+
+func (s *fakeChatServer) MarkRoomRead(ctx context.Context, req *chatpb.MarkRoomReadRequest) (*chatpb.MarkRoomReadResponse, error) {
+	return &chatpb.MarkRoomReadResponse{}, nil
+}
+
+func (s *fakeChatServer) JoinChat(stream chatpb.ChatService_JoinChatServer) error {
+	hello, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.joins++
+	join := s.joins
+	s.resumes = append(s.resumes, hello.GetMessage().GetResumeCursor())
+	s.mu.Unlock()
+
+	sentAt := timestamppb.New(time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC))
+	if join == 1 {
+		for i, text := range []string{"one", "two"} {
+			id := []string{"m1", "m2"}[i]
+			if err := stream.Send(&chatpb.ChatEvent{RoomId: "room-1", Event: &chatpb.ChatEvent_Message{Message: &chatpb.ChatMessage{
+				Roomid: "room-1", Username: "bob", Message: text, MessageId: id, Cursor: "c-" + id, SentAt: sentAt,
+			}}}); err != nil {
+				return err
+			}
+		}
+		return status.Error(codes.Unavailable, "server restarting")
+	}
+
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		saved := &chatpb.ChatMessage{
+			Roomid: "room-1", Username: "alice", Message: ev.GetMessage().GetMessage(), MessageId: "m3", Cursor: "c-m3", SentAt: sentAt,
+		}
+		if err := stream.Send(&chatpb.ChatEvent{RoomId: "room-1", Event: &chatpb.ChatEvent_Ack{Ack: &chatpb.MessageAckEvent{
+			ClientMessageId: ev.GetMessage().GetClientMessageId(), Message: saved,
+		}}}); err != nil {
+			return err
+		}
+		if err := stream.Send(&chatpb.ChatEvent{RoomId: "room-1", Event: &chatpb.ChatEvent_Message{Message: saved}}); err != nil {
+			return err
+		}
+	}
+}
+
+// syncBuffer: 수신 goroutine과 테스트가 같이 쓰는 출력 버퍼
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func waitFor(t *testing.T, out *syncBuffer, want string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !strings.Contains(out.String(), want) {
+		if time.Now().After(deadline) {
+			t.Fatalf("output does not contain %q:\n%s", want, out.String())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func startFakeServer(t *testing.T, chat *fakeChatServer) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	userpb.RegisterUserServiceServer(srv, fakeUserServer{})
+	chatpb.RegisterChatServiceServer(srv, chat)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestClientReconnectsAndResumes(t *testing.T) {
+	chat := &fakeChatServer{}
+	conn := startFakeServer(t, chat)
+	cfg := config.ClientConfig{Timeout: 5 * time.Second, ReconnectMaxDelay: 20 * time.Millisecond, PageSize: 20}
+	sess := newSession(cfg, userpb.NewUserServiceClient(conn), chatpb.NewChatServiceClient(conn))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := sess.login(ctx, "alice", "pw"); err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	out := &syncBuffer{}
+	input := make(chan string)
+	a := newApp(sess, newScreen(out, false), input)
+	a.minReconnectDelay = 5 * time.Millisecond
+	done := make(chan error, 1)
+	go func() { done <- a.run(ctx) }()
+
+	// 방 목록은 만료된 토큰 때문에 한 번 갱신한 뒤 받아짐
+	waitFor(t, out, "Bob (bob)")
+	if got := sess.token(); got != "t2" {
+		t.Fatalf("access token after refresh = %q, want t2", got)
+	}
+
+	input <- "1"
+	waitFor(t, out, "bob: two")
+	waitFor(t, out, "연결이 끊겼습니다")
+
+	// 끊긴 동안 입력한 메시지는 다시 접속하면 보내짐
+	input <- "hello"
+	waitFor(t, out, "alice: hello")
+
+	chat.mu.Lock()
+	resumes := chat.resumes
+	chat.mu.Unlock()
+	if len(resumes) < 2 || resumes[0] != "" || resumes[1] != "c-m2" {
+		t.Fatalf("resume cursors = %q, want [\"\" \"c-m2\" ...]", resumes)
+	}
+	if n := strings.Count(out.String(), "bob: one"); n != 1 {
+		t.Fatalf("history printed %d times, want once:\n%s", n, out.String())
+	}
+
+	input <- "/quit"
+	if err := <-done; err != nil {
+		t.Fatalf("run: %v", err)
+	}
+}
+
+func TestClientOpenDirect(t *testing.T) {
+	conn := startFakeServer(t, &fakeChatServer{})
+	cfg := config.ClientConfig{Timeout: 5 * time.Second, ReconnectMaxDelay: time.Second, PageSize: 20}
+	sess := newSession(cfg, userpb.NewUserServiceClient(conn), chatpb.NewChatServiceClient(conn))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := sess.login(ctx, "alice", "pw"); err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	out := &syncBuffer{}
+	input := make(chan string)
+	a := newApp(sess, newScreen(out, false), input)
+	done := make(chan error, 1)
+	go func() { done <- a.run(ctx) }()
+
+	waitFor(t, out, "Bob (bob)")
+	input <- "/dm carol"
+	waitFor(t, out, "user not found")
+	input <- "/dm bob"
+	waitFor(t, out, "──── bob ────")
+	waitFor(t, out, "bob: one")
+	input <- "/leave"
+	input <- "/quit"
+	if err := <-done; err != nil {
+		t.Fatalf("run: %v", err)
+	}
+}
@@ -0,0 +1,378 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const conversationHelp = `명령:
+  /more    이전 메시지 더 보기
+  /leave   방 목록으로 돌아가기
+  /quit    종료
+  그 외 입력은 메시지로 보냄`
+
+// errLeave: /leave (방 목록으로 돌아감)
+var errLeave = errors.New("leave")
+
+// conversation: 대화방 하나
+// 연결이 끊기면 마지막으로 받은 메시지의 cursor로 다시 입장해서 놓친 메시지를 이어 받고,
+// 서버가 받았다고 확인(ack)하지 않은 메시지는 같은 client_message_id로 다시 보냄 (서버가 중복을 걸러냄)
+type conversation struct {
+	app    *app
+	roomID string
+	title  string
+
+	mu            sync.Mutex
+	lastCursor    string // 마지막으로 받은 메시지 위치 (재접속 시 resume_cursor)
+	lastMessageID string // 나갈 때 여기까지 읽음 처리
+	oldestCursor  string // /more로 이보다 이전 메시지를 가져옴
+	reachedStart  bool   // /more로 방의 첫 메시지까지 봄
+	lastDay       string // 날짜 구분선을 넣기 위한 마지막 메시지 날짜
+	connected     bool   // 이번 연결에서 이벤트를 하나라도 받았는지 (재접속 대기 시간 초기화용)
+	pending       []*chatpb.ChatMessage
+}
+
+func newConversation(a *app, roomID, title string) *conversation {
+	return &conversation{app: a, roomID: roomID, title: title}
+}
+
+// run: /leave면 nil, /quit이나 입력 끝이면 errQuit, 다시 접속해도 안 되는 에러면 그 에러
+func (c *conversation) run(ctx context.Context) error {
+	scr := c.app.scr
+	scr.info("──── %s ──── (/more 이전 메시지, /leave 나가기, /help)", c.title)
+	scr.setPrompt(c.title + "> ")
+	defer c.markRead(ctx)
+
+	delay := c.app.minReconnectDelay
+	refreshed := false
+	for {
+		token := c.app.sess.token()
+		err := c.connect(ctx)
+		if c.takeConnected() {
+			delay, refreshed = c.app.minReconnectDelay, false
+		}
+		switch {
+		case err == errLeave:
+			c.dropPending()
+			return nil
+		case err == errQuit, ctx.Err() != nil:
+			return err
+		}
+
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+			// access token 만료: 한 번 갱신하고 바로 다시 접속 (갱신 직후에도 거부되면 포기)
+			if !refreshed {
+				if err := c.app.sess.refresh(ctx, token); err != nil {
+					return err
+				}
+				refreshed = true
+				continue
+			}
+			return err
+		case codes.PermissionDenied, codes.NotFound, codes.InvalidArgument, codes.FailedPrecondition:
+			return err
+		}
+
+		scr.errorf("연결이 끊겼습니다: %s", status.Convert(err).Message())
+		scr.info("%s 후 다시 접속합니다... (/leave로 취소)", delay)
+		switch err := c.wait(ctx, delay); {
+		case err == errLeave:
+			c.dropPending()
+			return nil
+		case err != nil:
+			return err
+		}
+		delay = min(delay*2, c.app.sess.cfg.ReconnectMaxDelay)
+	}
+}
+
+// connect: 한 번 입장해서 연결이 끊기거나 /leave, /quit을 입력할 때까지 이벤트를 주고받음
+func (c *conversation) connect(ctx context.Context) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.app.sess.chat.JoinChat(c.app.sess.authContext(streamCtx))
+	if err != nil {
+		return err
+	}
+
+	// 입장 핸드셰이크: 마지막으로 받은 위치 이후부터 (처음이면 서버가 최근 메시지 한 페이지를 보냄)
+	c.mu.Lock()
+	hello := &chatpb.ChatMessage{Roomid: c.roomID, ResumeCursor: c.lastCursor}
+	pending := slices.Clone(c.pending)
+	c.mu.Unlock()
+	for _, m := range append([]*chatpb.ChatMessage{hello}, pending...) {
+		if err := stream.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: m}}); err != nil {
+			// 보내기 실패의 실제 원인은 Recv로 받음
+			_, err = stream.Recv()
+			return err
+		}
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.receive(stream)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-done:
+			if err == io.EOF {
+				err = status.Error(codes.Unavailable, "server closed the stream")
+			}
+			return err
+		case line, ok := <-c.app.input:
+			c.app.scr.redraw()
+			if !ok {
+				return errQuit
+			}
+			if err := c.handleLine(ctx, stream, line); err != nil {
+				stream.CloseSend()
+				return err
+			}
+		}
+	}
+}
+
+// wait: 재접속 전에 d만큼 기다림 (그동안 입력한 메시지는 접속하면 보냄)
+func (c *conversation) wait(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return nil
+		case line, ok := <-c.app.input:
+			c.app.scr.redraw()
+			if !ok {
+				return errQuit
+			}
+			if err := c.handleLine(ctx, nil, line); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// handleLine: 입력 한 줄 처리 (stream이 nil이면 연결이 끊긴 상태)
+// /leave, /quit이면 errLeave / errQuit
+func (c *conversation) handleLine(ctx context.Context, stream chatpb.ChatService_JoinChatClient, line string) error {
+	line = strings.TrimRight(line, " \t")
+	cmd, _ := splitCommand(line)
+	switch cmd {
+	case "":
+		if strings.TrimSpace(line) != "" {
+			c.send(stream, line)
+		}
+	case "/leave", "/back":
+		return errLeave
+	case "/quit", "/q":
+		return errQuit
+	case "/more":
+		c.loadOlder(ctx)
+	case "/help", "/h":
+		c.app.scr.println(conversationHelp)
+	default:
+		c.app.scr.errorf("알 수 없는 명령: %s (/help)", cmd)
+	}
+	return nil
+}
+
+// send: ack를 받을 때까지 pending에 두고 보냄 (보내기 실패는 receive 쪽에서 연결 끊김으로 처리됨)
+func (c *conversation) send(stream chatpb.ChatService_JoinChatClient, text string) {
+	msg := &chatpb.ChatMessage{Roomid: c.roomID, Message: text, ClientMessageId: newClientMessageID()}
+	c.mu.Lock()
+	c.pending = append(c.pending, msg)
+	c.mu.Unlock()
+
+	if stream == nil {
+		c.app.scr.info("(연결되면 보냅니다)")
+		return
+	}
+	stream.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: msg}})
+}
+
+func newClientMessageID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// receive: 연결이 끊길 때까지 이벤트를 받아 출력
+func (c *conversation) receive(stream chatpb.ChatService_JoinChatClient) error {
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		c.mu.Lock()
+		c.connected = true
+		c.mu.Unlock()
+		c.handleEvent(ev)
+	}
+}
+
+func (c *conversation) handleEvent(ev *chatpb.ChatEvent) {
+	scr := c.app.scr
+	switch e := ev.Event.(type) {
+	case *chatpb.ChatEvent_Message:
+		c.showMessage(e.Message)
+	case *chatpb.ChatEvent_Ack:
+		c.acked(e.Ack.ClientMessageId)
+	case *chatpb.ChatEvent_Nack:
+		if e.Nack.Retryable {
+			scr.errorf("전송 실패 (%s), 다시 접속하면 다시 보냅니다.", e.Nack.Message)
+			return
+		}
+		c.acked(e.Nack.ClientMessageId)
+		scr.errorf("전송 실패: %s", e.Nack.Message)
+	case *chatpb.ChatEvent_MemberJoined:
+		scr.info("%s 님이 들어왔습니다.", e.MemberJoined.Username)
+	case *chatpb.ChatEvent_MemberLeft:
+		scr.info("%s 님이 나갔습니다.", e.MemberLeft.Username)
+	case *chatpb.ChatEvent_MemberRemoved:
+		scr.info("%s 님이 방에서 내보내졌습니다.", e.MemberRemoved.Username)
+	case *chatpb.ChatEvent_Presence:
+		scr.info("접속 중: %s", strings.Join(e.Presence.OnlineUsernames, ", "))
+	case *chatpb.ChatEvent_Error:
+		scr.errorf("오류: %s", e.Error.Message)
+	case *chatpb.ChatEvent_GoingAway:
+		scr.info("서버가 곧 종료됩니다. 연결이 끊기면 다시 접속합니다.")
+	}
+}
+
+// showMessage: 메시지 출력 (날짜가 바뀌면 구분선) + 재접속/이전 메시지용 위치 기록
+func (c *conversation) showMessage(m *chatpb.ChatMessage) {
+	scr := c.app.scr
+	c.mu.Lock()
+	var lines []string
+	if day := dayOf(m); day != c.lastDay {
+		lines = append(lines, scr.daySeparator(m.SentAt.AsTime()))
+		c.lastDay = day
+	}
+	if m.Cursor != "" {
+		c.lastCursor = m.Cursor
+		if c.oldestCursor == "" {
+			c.oldestCursor = m.Cursor
+		}
+	}
+	c.lastMessageID = m.MessageId
+	c.mu.Unlock()
+
+	lines = append(lines, scr.formatMessage(m, c.app.sess.me()))
+	scr.println(strings.Join(lines, "\n"))
+}
+
+func (c *conversation) acked(clientMessageID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pending = slices.DeleteFunc(c.pending, func(m *chatpb.ChatMessage) bool {
+		return m.ClientMessageId == clientMessageID
+	})
+}
+
+func (c *conversation) takeConnected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	connected := c.connected
+	c.connected = false
+	return connected
+}
+
+// dropPending: 방을 나갈 때 아직 확인받지 못한 메시지를 알려주고 버림
+func (c *conversation) dropPending() {
+	c.mu.Lock()
+	n := len(c.pending)
+	c.pending = nil
+	c.mu.Unlock()
+	if n > 0 {
+		c.app.scr.errorf("보내지 못한 메시지 %d개를 버렸습니다.", n)
+	}
+}
+
+// loadOlder: 지금 보이는 가장 오래된 메시지 이전 한 페이지를 출력
+func (c *conversation) loadOlder(ctx context.Context) {
+	scr := c.app.scr
+	c.mu.Lock()
+	cursor, reachedStart := c.oldestCursor, c.reachedStart
+	c.mu.Unlock()
+	if cursor == "" || reachedStart {
+		scr.info("이전 메시지가 없습니다.")
+		return
+	}
+
+	var resp *chatpb.GetMessagesResponse
+	err := c.app.sess.call(ctx, func(ctx context.Context) (err error) {
+		resp, err = c.app.sess.chat.GetMessages(ctx, &chatpb.GetMessagesRequest{
+			RoomId:    c.roomID,
+			Cursor:    cursor,
+			Direction: chatpb.PageDirection_PAGE_DIRECTION_BACKWARD,
+			Limit:     c.app.sess.cfg.PageSize,
+		})
+		return err
+	})
+	if err != nil {
+		scr.errorf("이전 메시지를 가져오지 못했습니다: %s", status.Convert(err).Message())
+		return
+	}
+	if len(resp.Messages) == 0 {
+		scr.info("이전 메시지가 없습니다.")
+		return
+	}
+
+	// 터미널은 위로 끼워 넣을 수 없으므로 이전 메시지를 한 덩어리로 출력
+	me := c.app.sess.me()
+	lines := []string{scr.style(styleDim, "──── 이전 메시지 ────")}
+	day := ""
+	for _, m := range resp.Messages {
+		if d := dayOf(m); d != day {
+			lines = append(lines, scr.daySeparator(m.SentAt.AsTime()))
+			day = d
+		}
+		lines = append(lines, scr.formatMessage(m, me))
+	}
+	if resp.HasMore {
+		lines = append(lines, scr.style(styleDim, "──── 여기까지 (/more로 더 보기) ────"))
+	} else {
+		lines = append(lines, scr.style(styleDim, "──── 처음 메시지입니다 ────"))
+	}
+	scr.println(strings.Join(lines, "\n"))
+
+	c.mu.Lock()
+	c.oldestCursor = resp.PrevCursor
+	if c.oldestCursor == "" {
+		c.oldestCursor = resp.Messages[0].Cursor
+	}
+	c.reachedStart = !resp.HasMore
+	c.mu.Unlock()
+}
+
+// markRead: 나갈 때 마지막으로 본 메시지까지 읽음 처리 (실패해도 무시)
+func (c *conversation) markRead(ctx context.Context) {
+	c.mu.Lock()
+	messageID := c.lastMessageID
+	c.mu.Unlock()
+	if messageID == "" || ctx.Err() != nil {
+		return
+	}
+	c.app.sess.call(ctx, func(ctx context.Context) error {
+		_, err := c.app.sess.chat.MarkRoomRead(ctx, &chatpb.MarkRoomReadRequest{RoomId: c.roomID, MessageId: messageID})
+		return err
+	})
+}
@@ -0,0 +1,97 @@
+// 터미널 채팅 클라이언트
+// 로그인 → 방 목록 → 대화방을 오가며 서비스를 손으로 테스트할 때 쓰는 도구
+//
+//	go run ./cmd/chat --client.usersvc_addr=host:50051 --client.chatsvc_addr=host:50052
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/server"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/userpb"
+)
+
+func main() {
+	// 0. 설정 로드 (서버 주소는 client 섹션: 설정 파일 / CHAT_* 환경 변수 / --client.* 플래그)
+	all := config.MustLoad("client", "tls")
+	cfg := all.Client
+
+	// 1. 서비스 연결 (실제 연결은 첫 호출 때 맺고, 끊기면 grpc가 다시 맺음)
+	// 서버가 tls.enabled면 여기서도 켜고, 개발용 CA면 tls.ca_file로 지정
+	creds, err := server.DialCredentials(all.TLS)
+	if err != nil {
+		log.Fatalf("failed to load TLS config: %v", err)
+	}
+	userConn, err := grpc.NewClient(cfg.UserServiceAddr, creds)
+	if err != nil {
+		log.Fatalf("failed to create UserService client: %v", err)
+	}
+	defer userConn.Close()
+	chatConn, err := grpc.NewClient(cfg.ChatServiceAddr, creds)
+	if err != nil {
+		log.Fatalf("failed to create ChatService client: %v", err)
+	}
+	defer chatConn.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// 2. 로그인 (아이디/비밀번호가 설정에 없으면 물어봄)
+	stdin := bufio.NewReader(os.Stdin)
+	scr := newScreen(os.Stdout, isTerminal(os.Stdout))
+	sess := newSession(cfg, userpb.NewUserServiceClient(userConn), chatpb.NewChatServiceClient(chatConn))
+
+	username, password := cfg.Username, cfg.Password
+	if username == "" {
+		fmt.Print("아이디: ")
+		username = readPrompt(stdin)
+	}
+	if password == "" {
+		fmt.Print("비밀번호: ")
+		restore := disableEcho(int(os.Stdin.Fd()))
+		password = readPrompt(stdin)
+		restore()
+		fmt.Println()
+	}
+	if err := sess.login(ctx, username, password); err != nil {
+		log.Fatalf("로그인 실패: %s", status.Convert(err).Message())
+	}
+
+	// 3. 방 목록 / 대화방 (종료할 때 이 세션을 로그아웃함)
+	err = newApp(sess, scr, readLines(stdin)).run(ctx)
+	sess.logout()
+	if err != nil && ctx.Err() == nil {
+		log.Fatalf("%s", status.Convert(err).Message())
+	}
+}
+
+func readPrompt(r *bufio.Reader) string {
+	line, _ := r.ReadString('\n')
+	return strings.TrimRight(line, "\r\n")
+}
+
+// readLines: 입력을 한 줄씩 채널로 보냄 (EOF면 채널을 닫음)
+func readLines(r io.Reader) <-chan string {
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+	return lines
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/status"
+)
+
+// errQuit: 대화방 안에서 /quit을 입력함 (프로그램 종료)
+var errQuit = errors.New("quit")
+
+const roomsHelp = `명령:
+  <번호>           방 열기
+  /dm <아이디>     1:1 대화 열기 (방이 없으면 만듦)
+  /rooms           방 목록 새로고침
+  /next            방 목록 다음 페이지
+  /quit            종료`
+
+// app: 방 목록 화면과 대화방을 오가는 메인 루프
+type app struct {
+	sess  *session
+	scr   *screen
+	input <-chan string // 입력 한 줄씩 (EOF면 닫힘)
+
+	minReconnectDelay time.Duration // 재접속 대기 시작값 (이후 두 배씩, 최대 client.reconnect_max_delay)
+
+	rooms     []*chatpb.ChatRoomInfo // 지금 보이는 방 목록 (번호 = 인덱스+1)
+	nextRooms string                 // 다음 페이지 토큰
+}
+
+func newApp(sess *session, scr *screen, input <-chan string) *app {
+	return &app{sess: sess, scr: scr, input: input, minReconnectDelay: time.Second}
+}
+
+// readLine: 입력 한 줄 (입력이 끝났거나 ctx가 끝나면 ok=false)
+func (a *app) readLine(ctx context.Context) (string, bool) {
+	select {
+	case <-ctx.Done():
+		return "", false
+	case line, ok := <-a.input:
+		a.scr.redraw()
+		return strings.TrimSpace(line), ok
+	}
+}
+
+// splitCommand: "/dm bob" → ("/dm", "bob"), 명령이 아니면 ("", line)
+func splitCommand(line string) (string, string) {
+	if !strings.HasPrefix(line, "/") {
+		return "", line
+	}
+	cmd, arg, _ := strings.Cut(line, " ")
+	return cmd, strings.TrimSpace(arg)
+}
+
+func (a *app) run(ctx context.Context) error {
+	a.scr.info("%s 님으로 로그인했습니다. /help로 명령을 볼 수 있습니다.", a.sess.me())
+	if err := a.loadRooms(ctx, ""); err != nil {
+		return err
+	}
+
+	for {
+		a.scr.setPrompt("rooms> ")
+		line, ok := a.readLine(ctx)
+		if !ok {
+			return nil
+		}
+
+		cmd, arg := splitCommand(line)
+		var err error
+		switch cmd {
+		case "":
+			if line == "" {
+				continue
+			}
+			n, convErr := strconv.Atoi(line)
+			if convErr != nil || n < 1 || n > len(a.rooms) {
+				a.scr.errorf("방 번호(1-%d)나 명령을 입력하세요. /help", len(a.rooms))
+				continue
+			}
+			err = a.open(ctx, a.rooms[n-1].RoomId, roomTitle(a.rooms[n-1]))
+		case "/dm":
+			if arg == "" {
+				a.scr.errorf("사용법: /dm <아이디>")
+				continue
+			}
+			err = a.openDirect(ctx, arg)
+		case "/rooms", "/r":
+			err = a.loadRooms(ctx, "")
+		case "/next":
+			if a.nextRooms == "" {
+				a.scr.info("마지막 페이지입니다.")
+				continue
+			}
+			err = a.loadRooms(ctx, a.nextRooms)
+		case "/help", "/h":
+			a.scr.println(roomsHelp)
+		case "/quit", "/q":
+			return nil
+		default:
+			a.scr.errorf("알 수 없는 명령: %s (/help)", cmd)
+		}
+
+		switch {
+		case err == errQuit:
+			return nil
+		case err == errSessionExpired, ctx.Err() != nil:
+			return err
+		case err != nil:
+			a.scr.errorf("%s", status.Convert(err).Message())
+		}
+	}
+}
+
+// open: 대화방에 들어갔다가 /leave로 나오면 방 목록을 다시 보여줌
+func (a *app) open(ctx context.Context, roomID, title string) error {
+	if err := newConversation(a, roomID, title).run(ctx); err != nil {
+		return err
+	}
+	return a.loadRooms(ctx, "")
+}
+
+// openDirect: GetRoomID로 상대와의 1:1 방을 찾아(없으면 만들어) 들어감
+func (a *app) openDirect(ctx context.Context, other string) error {
+	var resp *chatpb.GetRoomIDResponse
+	err := a.sess.call(ctx, func(ctx context.Context) (err error) {
+		resp, err = a.sess.chat.GetRoomID(ctx, &chatpb.GetRoomIDRequest{OtherId: other})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return a.open(ctx, resp.RoomId, other)
+}
+
+// loadRooms: GetMyRooms 한 페이지를 받아 번호를 붙여 출력 (pageToken이 비어 있으면 첫 페이지)
+func (a *app) loadRooms(ctx context.Context, pageToken string) error {
+	var resp *chatpb.GetMyRoomsResponse
+	err := a.sess.call(ctx, func(ctx context.Context) (err error) {
+		resp, err = a.sess.chat.GetMyRooms(ctx, &chatpb.GetMyRoomsRequest{Limit: a.sess.cfg.PageSize, PageToken: pageToken})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	a.rooms, a.nextRooms = resp.Rooms, resp.NextPageToken
+
+	if len(a.rooms) == 0 {
+		a.scr.info("참여 중인 방이 없습니다. /dm <아이디>로 대화를 시작하세요.")
+		return nil
+	}
+	var b strings.Builder
+	tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "#\tROOM\tUNREAD\tLAST MESSAGE")
+	for i, r := range a.rooms {
+		unread := "-"
+		if r.UnreadCount > 0 {
+			unread = strconv.Itoa(int(r.UnreadCount))
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", i+1, roomTitle(r), unread, lastMessage(r))
+	}
+	tw.Flush()
+	if a.nextRooms != "" {
+		b.WriteString("(/next로 다음 페이지)\n")
+	}
+	a.scr.println(strings.TrimRight(b.String(), "\n"))
+	return nil
+}
+
+// roomTitle: 그룹 방은 "# 이름 (인원)", 1:1 방은 상대 닉네임(아이디)
+func roomTitle(r *chatpb.ChatRoomInfo) string {
+	if r.IsGroup {
+		return fmt.Sprintf("# %s (%d)", r.Title, r.MemberCount)
+	}
+	if r.OtherUserNickname != "" && r.OtherUserNickname != r.OtherUserId {
+		return fmt.Sprintf("%s (%s)", r.OtherUserNickname, r.OtherUserId)
+	}
+	return r.OtherUserId
+}
+
+// lastMessage: "[01-02 15:04] 아이디: 미리보기"
+func lastMessage(r *chatpb.ChatRoomInfo) string {
+	if r.LastMessageId == "" {
+		return "-"
+	}
+	preview := strings.Join(strings.Fields(r.LastMessagePreview), " ")
+	return fmt.Sprintf("[%s] %s: %s", r.LastMessageAt.AsTime().Local().Format("01-02 15:04"), r.LastMessageUsername, preview)
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+)
+
+// ANSI 스타일 (터미널일 때만 씀)
+const (
+	styleReset = "\033[0m"
+	styleBold  = "\033[1m"
+	styleDim   = "\033[2m"
+	styleRed   = "\033[31m"
+	styleCyan  = "\033[36m"
+	clearLine  = "\r\033[K"
+)
+
+// screen: 터미널 출력
+// 메시지 수신 goroutine과 입력 프롬프트가 같은 줄에 섞이지 않도록 출력은 모두 여기를 거침
+type screen struct {
+	mu     sync.Mutex
+	w      io.Writer
+	ansi   bool // 터미널이면 색을 쓰고, 줄을 출력할 때마다 프롬프트를 지웠다가 다시 그림
+	prompt string
+}
+
+func newScreen(w io.Writer, ansi bool) *screen {
+	return &screen{w: w, ansi: ansi}
+}
+
+// isTerminal: 출력이 파이프/파일이 아닌 터미널인지
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+func (s *screen) style(code, text string) string {
+	if !s.ansi {
+		return text
+	}
+	return code + text + styleReset
+}
+
+// println: 한 줄(여러 줄도 가능) 출력 후 프롬프트를 다시 그림
+func (s *screen) println(text string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ansi {
+		fmt.Fprint(s.w, clearLine)
+	}
+	fmt.Fprintln(s.w, text)
+	if s.ansi {
+		fmt.Fprint(s.w, s.prompt)
+	}
+}
+
+func (s *screen) printf(format string, args ...any) {
+	s.println(fmt.Sprintf(format, args...))
+}
+
+// info: 안내 문구 (흐리게)
+func (s *screen) info(format string, args ...any) {
+	s.println(s.style(styleDim, fmt.Sprintf(format, args...)))
+}
+
+func (s *screen) errorf(format string, args ...any) {
+	s.println(s.style(styleRed, fmt.Sprintf(format, args...)))
+}
+
+// setPrompt: 입력 프롬프트를 바꾸고 그림 (터미널이 아니면 프롬프트를 출력하지 않음)
+func (s *screen) setPrompt(prompt string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prompt = prompt
+	if s.ansi {
+		fmt.Fprint(s.w, clearLine+prompt)
+	}
+}
+
+// redraw: 입력 한 줄을 받은 뒤 프롬프트를 다시 그림
+func (s *screen) redraw() {
+	s.setPrompt(s.prompt)
+}
+
+// formatMessage: "[15:04] 아이디: 내용" (여러 줄 메시지는 두 번째 줄부터 들여씀)
+func (s *screen) formatMessage(m *chatpb.ChatMessage, me string) string {
+	name := s.style(styleBold, m.Username)
+	if m.Username == me {
+		name = s.style(styleCyan, m.Username)
+	}
+	prefix := "[" + m.SentAt.AsTime().Local().Format("15:04") + "] "
+	body := strings.ReplaceAll(m.Message, "\n", "\n"+strings.Repeat(" ", len(prefix)+2))
+	return s.style(styleDim, prefix) + name + ": " + body
+}
+
+// daySeparator: 날짜가 바뀌는 곳에 넣는 줄
+func (s *screen) daySeparator(t time.Time) string {
+	return s.style(styleDim, "──── "+t.Local().Format("2006-01-02 (Mon)")+" ────")
+}
+
+// dayOf: 날짜 구분선을 넣을지 비교할 때 쓰는 값
+func dayOf(m *chatpb.ChatMessage) string {
+	return m.SentAt.AsTime().Local().Format("2006-01-02")
+}
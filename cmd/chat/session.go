@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/userpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// errSessionExpired: refresh token까지 만료/취소돼서 다시 로그인해야 함
+var errSessionExpired = errors.New("session expired, please log in again")
+
+// session: 로그인한 유저의 토큰과 두 서비스 클라이언트
+type session struct {
+	cfg   config.ClientConfig
+	users userpb.UserServiceClient
+	chat  chatpb.ChatServiceClient
+
+	mu           sync.Mutex
+	username     string
+	accessToken  string
+	refreshToken string
+}
+
+func newSession(cfg config.ClientConfig, users userpb.UserServiceClient, chat chatpb.ChatServiceClient) *session {
+	return &session{cfg: cfg, users: users, chat: chat}
+}
+
+func (s *session) login(ctx context.Context, username, password string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	resp, err := s.users.Login(ctx, &userpb.LoginRequest{Username: username, Password: password})
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.username = resp.GetUser().GetUsername()
+	if s.username == "" {
+		s.username = username
+	}
+	s.accessToken, s.refreshToken = resp.AccessToken, resp.RefreshToken
+	return nil
+}
+
+// logout: 이 세션을 끊음 (종료할 때, 실패해도 무시)
+func (s *session) logout() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+	s.users.Logout(s.authContext(ctx), &userpb.LogoutRequest{})
+}
+
+func (s *session) me() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.username
+}
+
+func (s *session) token() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.accessToken
+}
+
+// authContext: access token을 authorization metadata로 붙인 context
+func (s *session) authContext(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+s.token())
+}
+
+// refresh: stale 토큰이 거부됐을 때 refresh token으로 새 토큰을 받음
+// refresh token은 한 번만 쓸 수 있으므로 (두 번 쓰면 서버가 세션을 끊음) 동시에 한 번만 갱신하고,
+// 다른 goroutine이 이미 갱신했으면 그대로 둠
+func (s *session) refresh(ctx context.Context, stale string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.accessToken != stale {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+	resp, err := s.users.RefreshToken(ctx, &userpb.RefreshTokenRequest{RefreshToken: s.refreshToken})
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return errSessionExpired
+		}
+		return err
+	}
+	s.accessToken, s.refreshToken = resp.AccessToken, resp.RefreshToken
+	return nil
+}
+
+// call: 토큰을 붙여 요청 하나를 보냄. access token이 만료됐으면 한 번 갱신하고 다시 보냄
+func (s *session) call(ctx context.Context, fn func(ctx context.Context) error) error {
+	stale := s.token()
+	err := s.callOnce(ctx, fn)
+	if status.Code(err) != codes.Unauthenticated {
+		return err
+	}
+	if err := s.refresh(ctx, stale); err != nil {
+		return err
+	}
+	return s.callOnce(ctx, fn)
+}
+
+func (s *session) callOnce(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(s.authContext(ctx), s.cfg.Timeout)
+	defer cancel()
+	return fn(ctx)
+}
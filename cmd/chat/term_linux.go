@@ -0,0 +1,19 @@
+//go:build linux
+
+package main
+
+import "golang.org/x/sys/unix"
+
+// disableEcho: 비밀번호를 입력하는 동안 터미널 에코를 끔 (터미널이 아니면 아무것도 안 함)
+func disableEcho(fd int) (restore func()) {
+	t, err := unix.IoctlGetTermios(fd, unix.TCGETS)
+	if err != nil {
+		return func() {}
+	}
+	old := *t
+	t.Lflag &^= unix.ECHO
+	if err := unix.IoctlSetTermios(fd, unix.TCSETS, t); err != nil {
+		return func() {}
+	}
+	return func() { unix.IoctlSetTermios(fd, unix.TCSETS, &old) }
+}
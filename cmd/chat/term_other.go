@@ -0,0 +1,8 @@
+//go:build !linux
+
+package main
+
+// disableEcho: 리눅스가 아니면 에코를 끄지 않음 (비밀번호가 화면에 보이므로 client.password / CHAT_PASSWORD를 쓰세요)
+func disableEcho(fd int) (restore func()) {
+	return func() {}
+}
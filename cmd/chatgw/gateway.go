@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/userpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Gateway: 웹/모바일 클라이언트용 REST(JSON) + WebSocket 게이트웨이
+// 요청을 그대로 UserService / ChatService gRPC 호출로 바꾸고, 인증은 뒤쪽 서비스의 인터셉터에 맡김 (토큰은 전달만 함)
+type Gateway struct {
+	conns   map[protoreflect.FullName]grpc.ClientConnInterface // 서비스 이름별 연결 (REST 라우트가 사용)
+	chat    chatpb.ChatServiceClient
+	origins []string // 허용할 Origin (비어 있으면 다른 Origin의 브라우저 요청은 모두 거부)
+}
+
+// NewGateway: 생성자 (연결은 호출한 쪽이 만들고 닫음)
+func NewGateway(cfg config.ChatGatewayConfig, userConn, chatConn grpc.ClientConnInterface) *Gateway {
+	var origins []string
+	for _, o := range strings.Split(cfg.AllowedOrigins, ",") {
+		if o = strings.TrimSpace(o); o != "" {
+			origins = append(origins, strings.TrimRight(o, "/"))
+		}
+	}
+	return &Gateway{
+		conns: map[protoreflect.FullName]grpc.ClientConnInterface{
+			protoreflect.FullName(userpb.UserService_ServiceDesc.ServiceName): userConn,
+			protoreflect.FullName(chatpb.ChatService_ServiceDesc.ServiceName): chatConn,
+		},
+		chat:    chatpb.NewChatServiceClient(chatConn),
+		origins: origins,
+	}
+}
+
+// Handler: REST 라우트 + /v1/chat/ws를 묶은 HTTP 핸들러
+func (g *Gateway) Handler() http.Handler {
+	mux := http.NewServeMux()
+	for _, rt := range routes {
+		mux.Handle(rt.pattern, g.unaryHandler(rt))
+	}
+	mux.HandleFunc("GET "+chatSocketPath, g.serveChat)
+	return g.cors(mux)
+}
+
+// originAllowed: 설정(chatgw.allowed_origins)에 있는 Origin인지
+// WebSocket은 ?access_token=으로도 인증하므로 와일드카드 없이 적어 둔 Origin만 허용함
+func (g *Gateway) originAllowed(origin string) bool {
+	return slices.Contains(g.origins, strings.TrimRight(origin, "/"))
+}
+
+// cors: 브라우저 클라이언트용 CORS 헤더와 preflight(OPTIONS) 처리
+func (g *Gateway) cors(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin != "" && g.originAllowed(origin) {
+			h := w.Header()
+			h.Set("Access-Control-Allow-Origin", origin)
+			h.Add("Vary", "Origin")
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				h.Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE")
+				h.Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+				h.Set("Access-Control-Max-Age", "600")
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// outgoingContext: HTTP 요청의 bearer 토큰을 gRPC authorization metadata로 옮긴 context
+// 브라우저 WebSocket은 헤더를 못 붙이므로 allowQuery면 ?access_token= 도 받음
+func outgoingContext(r *http.Request, allowQuery bool) context.Context {
+	ctx := r.Context()
+	token := r.Header.Get("Authorization")
+	if token == "" && allowQuery {
+		if t := r.URL.Query().Get("access_token"); t != "" {
+			token = "Bearer " + t
+		}
+	}
+	if token == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", token)
+}
@@ -0,0 +1,306 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/user"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/userpb"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeUserService: DB 없이 alice/pw 한 명만 있는 user.Service (쓰지 않는 메서드는 nil 임베드라 부르면 panic)
+type fakeUserService struct {
+	user.Service
+}
+
+var alice = &user.User{ID: "u-alice", Username: "alice", Name: "Alice", Email: "alice@example.com"}
+
+func (fakeUserService) Login(ctx context.Context, username, password string) (*user.User, error) {
+	if username != "alice" || password != "pw" {
+		return nil, errors.New("invalid credentials")
+	}
+	return alice, nil
+}
+
+func (fakeUserService) IssueTokens(ctx context.Context, u *user.User) (*user.TokenPair, error) {
+	access, err := user.GenerateAccessToken(u, "s1")
+	if err != nil {
+		return nil, err
+	}
+	return &user.TokenPair{AccessToken: access, RefreshToken: "refresh", SessionID: "s1"}, nil
+}
+
+func (fakeUserService) GetProfile(ctx context.Context, userID string) (*user.User, error) {
+	return alice, nil
+}
+
+func (fakeUserService) CheckUsername(ctx context.Context, username string) (bool, error) {
+	return username != "alice", nil
+}
+
+// fakeChatServer: JoinChat은 입장하면 방 ID와 토큰의 유저명을 알려주고, 이후 메시지는 ack로 돌려줌
+type fakeChatServer struct {
+	chatpb.UnimplementedChatServiceServer
+}
+
+func (fakeChatServer) JoinChat(stream chatpb.ChatService_JoinChatServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if first.GetMessage().GetRoomid() == "" {
+		return status.Error(codes.InvalidArgument, "첫 이벤트는 입장 메시지여야 함")
+	}
+	roomID := first.GetMessage().GetRoomid()
+	username, _ := user.UsernameFromContext(stream.Context())
+	if err := stream.Send(&chatpb.ChatEvent{RoomId: roomID, Event: &chatpb.ChatEvent_Presence{
+		Presence: &chatpb.PresenceEvent{OnlineUsernames: []string{username}},
+	}}); err != nil {
+		return err
+	}
+
+	for {
+		ev, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		msg := ev.GetMessage()
+		msg.Username = username
+		if err := stream.Send(&chatpb.ChatEvent{RoomId: roomID, Event: &chatpb.ChatEvent_Ack{
+			Ack: &chatpb.MessageAckEvent{ClientMessageId: msg.GetClientMessageId(), Message: msg},
+		}}); err != nil {
+			return err
+		}
+	}
+}
+
+func (fakeChatServer) ListRoomMembers(ctx context.Context, req *chatpb.ListRoomMembersRequest) (*chatpb.ListRoomMembersResponse, error) {
+	return &chatpb.ListRoomMembersResponse{Members: []*chatpb.RoomMember{{UserId: "member-of-" + req.RoomId, Role: "owner"}}}, nil
+}
+
+// bufconnClient: 서버를 메모리 리스너에서 띄우고 그 서버로 가는 연결을 돌려줌
+func bufconnClient(t *testing.T, srv *grpc.Server) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// newTestGateway: 인증 인터셉터가 붙은 usersvc / chatsvc를 bufconn으로 띄우고 게이트웨이 HTTP 서버를 돌려줌
+func newTestGateway(t *testing.T) *httptest.Server {
+	t.Helper()
+	t.Setenv("JWT_SECRET", "gateway-test-secret")
+
+	userSrv := grpc.NewServer(grpc.UnaryInterceptor(user.UnaryAuthInterceptor))
+	userpb.RegisterUserServiceServer(userSrv, user.NewHandler(fakeUserService{}))
+
+	chatSrv := grpc.NewServer(
+		grpc.UnaryInterceptor(user.UnaryAuthInterceptor),
+		grpc.StreamInterceptor(user.StreamAuthInterceptor),
+	)
+	chatpb.RegisterChatServiceServer(chatSrv, fakeChatServer{})
+
+	cfg := config.Default().ChatGateway
+	cfg.AllowedOrigins = "https://chat.example.com"
+	gw := NewGateway(cfg, bufconnClient(t, userSrv), bufconnClient(t, chatSrv))
+
+	ts := httptest.NewServer(gw.Handler())
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// call: REST 요청을 보내고 상태 코드와 JSON 응답을 돌려줌
+func call(t *testing.T, method, url, token, body string) (int, map[string]any) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	out := map[string]any{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		t.Fatalf("%s %s: invalid JSON response: %v", method, url, err)
+	}
+	return resp.StatusCode, out
+}
+
+func TestRESTForwardsToUserService(t *testing.T) {
+	ts := newTestGateway(t)
+
+	code, body := call(t, "POST", ts.URL+"/v1/auth/login", "", `{"username":"alice","password":"pw"}`)
+	if code != http.StatusOK {
+		t.Fatalf("login: %d %v", code, body)
+	}
+	token, _ := body["access_token"].(string)
+	if token == "" || body["refresh_token"] != "refresh" {
+		t.Fatalf("login response = %v", body)
+	}
+
+	// 토큰이 없으면 usersvc 인터셉터의 UNAUTHENTICATED가 401로
+	if code, body := call(t, "GET", ts.URL+"/v1/me", "", ""); code != http.StatusUnauthorized || body["code"] != "UNAUTHENTICATED" {
+		t.Errorf("profile without token: %d %v", code, body)
+	}
+	code, body = call(t, "GET", ts.URL+"/v1/me", token, "")
+	if u, _ := body["user"].(map[string]any); code != http.StatusOK || u["username"] != "alice" {
+		t.Errorf("profile with token: %d %v", code, body)
+	}
+
+	// GetProfile은 토큰 주인의 프로필만 돌려주므로 다른 유저 ID 경로로는 노출하지 않음
+	req, _ := http.NewRequest("GET", ts.URL+"/v1/users/some-other-user", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	if resp, err := http.DefaultClient.Do(req); err != nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("GET /v1/users/{id}: resp = %v, err = %v, want 404", resp, err)
+	} else {
+		resp.Body.Close()
+	}
+
+	// 쿼리 스트링 → 요청 필드, 빈 값(false)도 응답에 포함
+	code, body = call(t, "GET", ts.URL+"/v1/users/check-username?username=alice", "", "")
+	if code != http.StatusOK || body["available"] != false {
+		t.Errorf("check-username: %d %v", code, body)
+	}
+	if code, body := call(t, "GET", ts.URL+"/v1/users/check-username?user=alice", "", ""); code != http.StatusBadRequest {
+		t.Errorf("unknown query parameter: %d %v", code, body)
+	}
+	if code, body := call(t, "POST", ts.URL+"/v1/auth/login", "", `{"username":"alice","password":"nope"}`); code != http.StatusInternalServerError || body["code"] != "INTERNAL" {
+		t.Errorf("bad login: %d %v", code, body)
+	}
+}
+
+// 기본 설정에는 허용한 Origin이 없으므로 다른 Origin의 브라우저 요청에 CORS 헤더를 주지 않음
+func TestCORSDefaultsToNoOrigins(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusTeapot) })
+	for _, tc := range []struct {
+		origins string
+		allowed bool
+	}{
+		{config.Default().ChatGateway.AllowedOrigins, false},
+		{"https://chat.example.com/, https://admin.example.com", true},
+	} {
+		cfg := config.Default().ChatGateway
+		cfg.AllowedOrigins = tc.origins
+		handler := NewGateway(cfg, nil, nil).cors(next)
+
+		req := httptest.NewRequest(http.MethodOptions, "/v1/me", nil)
+		req.Header.Set("Origin", "https://chat.example.com")
+		req.Header.Set("Access-Control-Request-Method", "GET")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if got := rec.Header().Get("Access-Control-Allow-Origin") != ""; got != tc.allowed {
+			t.Errorf("allowed_origins=%q: CORS allowed = %v, want %v", tc.origins, got, tc.allowed)
+		}
+	}
+}
+
+func TestRESTPathParameters(t *testing.T) {
+	ts := newTestGateway(t)
+	_, body := call(t, "POST", ts.URL+"/v1/auth/login", "", `{"username":"alice","password":"pw"}`)
+	token := body["access_token"].(string)
+
+	code, body := call(t, "GET", ts.URL+"/v1/rooms/room-1/members", token, "")
+	members, _ := body["members"].([]any)
+	if code != http.StatusOK || len(members) != 1 || members[0].(map[string]any)["user_id"] != "member-of-room-1" {
+		t.Errorf("list members: %d %v", code, body)
+	}
+}
+
+func TestChatWebSocketBridge(t *testing.T) {
+	ts := newTestGateway(t)
+	_, body := call(t, "POST", ts.URL+"/v1/auth/login", "", `{"username":"alice","password":"pw"}`)
+	token := body["access_token"].(string)
+	wsURL := "ws" + strings.TrimPrefix(ts.URL, "http") + chatSocketPath
+
+	dial := func(query, origin string) (*websocket.Conn, error) {
+		return websocket.Dial(wsURL+query, "", origin)
+	}
+	recv := func(ws *websocket.Conn) map[string]any {
+		t.Helper()
+		ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+		var frame string
+		if err := websocket.Message.Receive(ws, &frame); err != nil {
+			t.Fatalf("receive: %v", err)
+		}
+		ev := map[string]any{}
+		if err := json.Unmarshal([]byte(frame), &ev); err != nil {
+			t.Fatalf("invalid frame %q: %v", frame, err)
+		}
+		return ev
+	}
+
+	// 허용하지 않은 Origin은 핸드셰이크에서 거부
+	if _, err := dial("?access_token="+token, "https://evil.example.com"); err == nil {
+		t.Error("handshake from a foreign origin succeeded")
+	}
+
+	// 토큰 없이 접속하면 스트림이 UNAUTHENTICATED로 끝난 것을 error 이벤트로 받음
+	ws, err := dial("", "https://chat.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	websocket.Message.Send(ws, `{"message":{"roomid":"room-1"}}`)
+	if e, _ := recv(ws)["error"].(map[string]any); e["code"] != float64(codes.Unauthenticated) {
+		t.Errorf("error frame = %v, want UNAUTHENTICATED", e)
+	}
+	ws.Close()
+
+	ws, err = dial("?access_token="+token, "https://chat.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ws.Close()
+
+	websocket.Message.Send(ws, `{"message":{"roomid":"room-1"}}`)
+	ev := recv(ws)
+	if p, _ := ev["presence"].(map[string]any); ev["room_id"] != "room-1" || p == nil || p["online_usernames"].([]any)[0] != "alice" {
+		t.Fatalf("join frame = %v", ev)
+	}
+
+	// 잘못된 프레임은 error 이벤트만 받고 스트림은 계속됨
+	websocket.Message.Send(ws, `{"mesage":{}}`)
+	if e, _ := recv(ws)["error"].(map[string]any); e["code"] != float64(codes.InvalidArgument) {
+		t.Errorf("bad frame: error = %v, want INVALID_ARGUMENT", e)
+	}
+
+	websocket.Message.Send(ws, `{"message":{"roomid":"room-1","message":"hi","client_message_id":"c1"}}`)
+	ack, _ := recv(ws)["ack"].(map[string]any)
+	if msg, _ := ack["message"].(map[string]any); ack["client_message_id"] != "c1" || msg["message"] != "hi" || msg["username"] != "alice" {
+		t.Errorf("ack frame = %v", ack)
+	}
+}
@@ -1,6 +1,74 @@
 // 채팅용 gateway 서버
+// 웹/모바일 클라이언트가 쓸 REST(JSON) API와 채팅 WebSocket을 UserService / ChatService gRPC로 이어줌
 package main
 
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"os/signal"
+	"syscall"
+
+	"google.golang.org/grpc"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/server"
+)
+
 func main() {
-	println("Chat gateway running")
+	// 0. 설정 로드 (게이트웨이는 DB/JWT 설정이 필요 없으므로 chatgw 섹션만 검증)
+	cfg := config.MustLoad("chatgw", "tls")
+	gwCfg := cfg.ChatGateway
+
+	// 1. 뒤쪽 gRPC 서비스 연결 (실제 연결은 첫 호출 때 맺음)
+	// tls.cert_file/key_file이 있으면 클라이언트 인증서를 보냄 (서비스가 tls.client_auth=require면 필수)
+	creds, err := server.DialCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("failed to load TLS config: %v", err)
+	}
+	userConn, err := grpc.NewClient(gwCfg.UserServiceAddr, creds)
+	if err != nil {
+		log.Fatalf("failed to create UserService client: %v", err)
+	}
+	defer userConn.Close()
+	chatConn, err := grpc.NewClient(gwCfg.ChatServiceAddr, creds)
+	if err != nil {
+		log.Fatalf("failed to create ChatService client: %v", err)
+	}
+	defer chatConn.Close()
+
+	// 2. HTTP 서버
+	gw := NewGateway(gwCfg, userConn, chatConn)
+	srv := &http.Server{Addr: gwCfg.ListenAddr, Handler: gw.Handler()}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Printf("Chat gateway listening on %s (UserService %s, ChatService %s)", gwCfg.ListenAddr, gwCfg.UserServiceAddr, gwCfg.ChatServiceAddr)
+		serveErr <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("failed to serve: %v", err)
+		}
+		return
+	case <-ctx.Done():
+	}
+	stop()
+
+	// 3. 종료: 새 요청을 받지 않고 진행 중인 REST 요청을 기다림
+	// WebSocket은 hijack된 연결이라 Shutdown이 기다리지 않으므로 gRPC 연결을 닫아서 스트림을 끝냄
+	log.Printf("종료 신호를 받았습니다. 최대 %s 동안 정리합니다...", gwCfg.ShutdownTimeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), gwCfg.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Printf("종료 제한 시간 초과: %v", err)
+	} else {
+		log.Println("게이트웨이가 정상 종료되었습니다.")
+	}
 }
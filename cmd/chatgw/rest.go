@@ -0,0 +1,292 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// 요청 본문 최대 크기
+const maxBodyBytes = 1 << 20
+
+// route: REST 엔드포인트 하나 = unary RPC 하나
+// 요청 메시지는 JSON 본문 + 쿼리 스트링 + 경로의 {필드}를 합쳐서 만듦 (이름은 proto 필드 이름)
+type route struct {
+	pattern string                // http.ServeMux 패턴 ("POST /v1/auth/login")
+	method  protoreflect.FullName // gRPC 메서드 ("user.v1.UserService.Login")
+}
+
+var routes = []route{
+	// 회원가입 / 로그인 / 토큰
+	{"POST /v1/auth/signup", "user.v1.UserService.SignUp"},
+	{"POST /v1/auth/login", "user.v1.UserService.Login"},
+	{"POST /v1/auth/social-login", "user.v1.UserService.SocialLogin"},
+	{"POST /v1/auth/refresh", "user.v1.UserService.RefreshToken"},
+	{"POST /v1/auth/logout", "user.v1.UserService.Logout"},
+	{"POST /v1/auth/logout-all", "user.v1.UserService.LogoutAllDevices"},
+
+	// 중복 체크 / 전화번호 인증
+	{"GET /v1/users/check-username", "user.v1.UserService.CheckUsername"},
+	{"GET /v1/users/check-email", "user.v1.UserService.CheckEmail"},
+	{"POST /v1/phone/verifications", "user.v1.UserService.RequestPhoneVerification"},
+	{"POST /v1/phone/verifications/{verification_id}/verify", "user.v1.UserService.VerifyPhone"},
+
+	// 프로필 / 검색 (GetProfile은 토큰 주인의 프로필만 돌려주므로 /v1/me로만 노출)
+	{"GET /v1/users/search", "user.v1.UserService.SearchUsers"},
+	{"GET /v1/me", "user.v1.UserService.GetProfile"},
+	{"PATCH /v1/me", "user.v1.UserService.UpdateProfile"},
+	{"PUT /v1/me/password", "user.v1.UserService.ChangePassword"},
+	{"PUT /v1/me/avatar", "user.v1.UserService.UpdateAvatar"},
+
+	// 소셜 계정 연결 ({provider}는 SOCIAL_PROVIDER_KAKAO 같은 enum 이름)
+	{"GET /v1/me/social-accounts", "user.v1.UserService.ListSocialAccounts"},
+	{"POST /v1/me/social-accounts", "user.v1.UserService.LinkSocialAccount"},
+	{"DELETE /v1/me/social-accounts/{provider}", "user.v1.UserService.UnlinkSocialAccount"},
+
+	// 채팅방 (실시간 송수신은 WebSocket /v1/chat/ws)
+	{"GET /v1/rooms", "chat.v1.ChatService.GetMyRooms"},
+	{"POST /v1/rooms", "chat.v1.ChatService.CreateGroupRoom"},
+	{"POST /v1/rooms/direct", "chat.v1.ChatService.GetRoomID"},
+	{"PATCH /v1/rooms/{room_id}", "chat.v1.ChatService.RenameRoom"},
+	{"GET /v1/rooms/{room_id}/messages", "chat.v1.ChatService.GetMessages"},
+	{"POST /v1/rooms/{room_id}/read", "chat.v1.ChatService.MarkRoomRead"},
+	{"GET /v1/rooms/{room_id}/members", "chat.v1.ChatService.ListRoomMembers"},
+	{"POST /v1/rooms/{room_id}/members", "chat.v1.ChatService.AddRoomMembers"},
+	{"DELETE /v1/rooms/{room_id}/members/{member_id}", "chat.v1.ChatService.RemoveRoomMember"},
+}
+
+var pathParamPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+// 응답 JSON: proto 필드 이름 그대로, 빈 값도 모두 내보냄 (클라이언트가 필드 유무를 따지지 않아도 되게)
+var responseJSON = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// unaryHandler: 라우트를 처리하는 핸들러 (라우트 표가 잘못됐으면 시작할 때 panic)
+func (g *Gateway) unaryHandler(rt route) http.Handler {
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(rt.method)
+	if err != nil {
+		panic(fmt.Sprintf("chatgw: route %q: %v", rt.pattern, err))
+	}
+	md, ok := d.(protoreflect.MethodDescriptor)
+	if !ok || md.IsStreamingClient() || md.IsStreamingServer() {
+		panic(fmt.Sprintf("chatgw: route %q: %s is not a unary method", rt.pattern, rt.method))
+	}
+	svc := md.Parent().(protoreflect.ServiceDescriptor)
+	conn := g.conns[svc.FullName()]
+	if conn == nil {
+		panic(fmt.Sprintf("chatgw: route %q: no connection for %s", rt.pattern, svc.FullName()))
+	}
+	inType, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+	if err != nil {
+		panic(err)
+	}
+	outType, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if err != nil {
+		panic(err)
+	}
+
+	fullMethod := fmt.Sprintf("/%s/%s", svc.FullName(), md.Name())
+	var pathParams []string
+	for _, m := range pathParamPattern.FindAllStringSubmatch(rt.pattern, -1) {
+		pathParams = append(pathParams, m[1])
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		in := inType.New().Interface()
+		if err := decodeRequest(r, pathParams, in); err != nil {
+			writeError(w, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		out := outType.New().Interface()
+		if err := conn.Invoke(outgoingContext(r, false), fullMethod, in, out); err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+}
+
+// decodeRequest: JSON 본문(객체) 위에 쿼리 스트링과 경로 값을 덮어써서 요청 메시지를 채움
+func decodeRequest(r *http.Request, pathParams []string, in proto.Message) error {
+	fields := make(map[string]json.RawMessage)
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodyBytes+1))
+	if err != nil {
+		return fmt.Errorf("failed to read body: %w", err)
+	}
+	if len(body) > maxBodyBytes {
+		return errors.New("body too large")
+	}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &fields); err != nil {
+			return errors.New("body must be a JSON object")
+		}
+	}
+
+	desc := in.ProtoReflect().Descriptor()
+	for name, values := range r.URL.Query() {
+		if err := setField(desc, fields, name, values); err != nil {
+			return err
+		}
+	}
+	for _, name := range pathParams {
+		if err := setField(desc, fields, name, []string{r.PathValue(name)}); err != nil {
+			return err
+		}
+	}
+
+	merged, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return protojson.Unmarshal(merged, in)
+}
+
+// setField: 문자열 값을 필드 타입에 맞는 JSON 값으로 바꿔 넣음
+// 숫자/enum은 protojson이 문자열도 받으므로 그대로 두고, bool만 변환함
+func setField(desc protoreflect.MessageDescriptor, fields map[string]json.RawMessage, name string, values []string) error {
+	fd := desc.Fields().ByName(protoreflect.Name(name))
+	if fd == nil {
+		fd = desc.Fields().ByJSONName(name)
+	}
+	if fd == nil || fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind || fd.IsMap() {
+		return fmt.Errorf("unknown parameter %q", name)
+	}
+
+	encoded := make([]any, 0, len(values))
+	for _, v := range values {
+		if fd.Kind() != protoreflect.BoolKind {
+			encoded = append(encoded, v)
+			continue
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("parameter %q: invalid boolean %q", name, v)
+		}
+		encoded = append(encoded, b)
+	}
+
+	var value any = encoded
+	if !fd.IsList() {
+		if len(encoded) != 1 {
+			return fmt.Errorf("parameter %q must have exactly one value", name)
+		}
+		value = encoded[0]
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	fields[string(fd.Name())] = raw
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, msg proto.Message) {
+	data, err := responseJSON.Marshal(msg)
+	if err != nil {
+		log.Printf("응답 변환 실패: %v", err)
+		http.Error(w, `{"code":"INTERNAL","message":"failed to encode response"}`, http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(data)
+}
+
+// writeError: gRPC 에러를 HTTP 상태 코드 + {"code": "NOT_FOUND", "message": "..."}로 바꿔서 씀
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	body, _ := json.Marshal(map[string]string{
+		"code":    codeName(st.Code()),
+		"message": st.Message(),
+	})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(st.Code()))
+	w.Write(body)
+}
+
+// codeName: google.rpc.Code 이름 (NOT_FOUND 등)
+func codeName(c codes.Code) string {
+	switch c {
+	case codes.OK:
+		return "OK"
+	case codes.Canceled:
+		return "CANCELLED"
+	case codes.Unknown:
+		return "UNKNOWN"
+	case codes.InvalidArgument:
+		return "INVALID_ARGUMENT"
+	case codes.DeadlineExceeded:
+		return "DEADLINE_EXCEEDED"
+	case codes.NotFound:
+		return "NOT_FOUND"
+	case codes.AlreadyExists:
+		return "ALREADY_EXISTS"
+	case codes.PermissionDenied:
+		return "PERMISSION_DENIED"
+	case codes.ResourceExhausted:
+		return "RESOURCE_EXHAUSTED"
+	case codes.FailedPrecondition:
+		return "FAILED_PRECONDITION"
+	case codes.Aborted:
+		return "ABORTED"
+	case codes.OutOfRange:
+		return "OUT_OF_RANGE"
+	case codes.Unimplemented:
+		return "UNIMPLEMENTED"
+	case codes.Internal:
+		return "INTERNAL"
+	case codes.Unavailable:
+		return "UNAVAILABLE"
+	case codes.DataLoss:
+		return "DATA_LOSS"
+	case codes.Unauthenticated:
+		return "UNAUTHENTICATED"
+	default:
+		return c.String()
+	}
+}
+
+// httpStatus: gRPC 코드 → HTTP 상태 코드 (google.rpc.Code 문서의 대응표)
+func httpStatus(c codes.Code) int {
+	switch c {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499 // Client Closed Request
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	chatSocketPath = "/v1/chat/ws"
+
+	maxFrameBytes = 64 << 10 // 클라이언트 프레임 최대 크기 (메시지 본문 상한보다 넉넉하게)
+)
+
+// serveChat: WebSocket을 ChatService.JoinChat 스트림에 1:1로 이어줌
+//   - 클라이언트 → 서버: 텍스트 프레임 하나 = ChatClientEvent JSON 하나 (첫 프레임은 입장 메시지)
+//   - 서버 → 클라이언트: ChatEvent 하나 = 텍스트 프레임 하나
+//
+// 스트림이 에러로 끝나면 그 코드를 error 이벤트로 한 번 보내고 소켓을 닫음
+func (g *Gateway) serveChat(w http.ResponseWriter, r *http.Request) {
+	ctx := outgoingContext(r, true)
+	srv := websocket.Server{
+		Handshake: g.checkOrigin,
+		Handler: func(ws *websocket.Conn) {
+			ws.MaxPayloadBytes = maxFrameBytes
+			g.bridge(ctx, ws)
+		},
+	}
+	srv.ServeHTTP(w, r)
+}
+
+// checkOrigin: 브라우저(Origin 헤더가 있는 요청)는 허용한 Origin만 받음
+func (g *Gateway) checkOrigin(cfg *websocket.Config, r *http.Request) error {
+	origin, err := websocket.Origin(cfg, r)
+	if err != nil {
+		return err
+	}
+	cfg.Origin = origin
+	if origin != nil && !g.originAllowed(origin.String()) {
+		return errors.New("origin not allowed")
+	}
+	return nil
+}
+
+// bridge: 두 방향을 각각 옮기다가 한쪽이 끝나면 다른 쪽도 정리함
+func (g *Gateway) bridge(ctx context.Context, ws *websocket.Conn) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer ws.Close()
+
+	stream, err := g.chat.JoinChat(ctx)
+	if err != nil {
+		sendError(ws, err)
+		return
+	}
+
+	// 클라이언트 → gRPC
+	// 소켓이 닫히면 스트림을 half-close하고, 연결이 끊긴 거라면 스트림도 취소함
+	go func() {
+		for {
+			var data []byte
+			if err := websocket.Message.Receive(ws, &data); err != nil {
+				if !errors.Is(err, io.EOF) {
+					cancel()
+				}
+				stream.CloseSend()
+				return
+			}
+			ev := &chatpb.ChatClientEvent{}
+			if err := protojson.Unmarshal(data, ev); err != nil {
+				// 잘못된 프레임 하나 때문에 스트림을 끊지는 않음 (서버의 ErrorEvent와 같은 방식)
+				sendError(ws, status.Errorf(codes.InvalidArgument, "invalid event: %v", err))
+				continue
+			}
+			if err := stream.Send(ev); err != nil {
+				// 스트림이 끝난 이유는 아래 Recv 쪽에서 받아서 알려줌
+				return
+			}
+		}
+	}()
+
+	// gRPC → 클라이언트 (websocket.Conn은 쓰기를 내부에서 잠그므로 위 goroutine의 sendError와 같이 써도 됨)
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+				sendError(ws, err)
+			}
+			return
+		}
+		data, err := responseJSON.Marshal(ev)
+		if err != nil {
+			log.Printf("이벤트 변환 실패: %v", err)
+			continue
+		}
+		if err := websocket.Message.Send(ws, string(data)); err != nil {
+			return
+		}
+	}
+}
+
+// sendError: gRPC 에러를 ChatEvent.error 프레임으로 보냄
+func sendError(ws *websocket.Conn, err error) {
+	st := status.Convert(err)
+	ev := &chatpb.ChatEvent{Event: &chatpb.ChatEvent_Error{Error: &chatpb.ErrorEvent{
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+	}}}
+	data, err := responseJSON.Marshal(ev)
+	if err != nil {
+		return
+	}
+	websocket.Message.Send(ws, string(data))
+}
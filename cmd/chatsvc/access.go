@@ -0,0 +1,49 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchAccess: 스트림이 열려 있는 동안 chatsvc.access_check_interval마다 세션과 방 멤버 여부를 다시 확인
+// 세션이 끊겼으면(로그아웃/차단/강제 로그아웃/유저 삭제) Unauthenticated, 방에서 빠졌으면 PermissionDenied로 스트림을 닫음
+// 다른 서비스(UserService 관리자 API 등)에서 일어난 변경은 이 인스턴스에 알림이 오지 않으므로 주기적으로 확인함
+// 확인 자체가 실패하면(DB 장애) 끊지 않고 다음 주기에 다시 확인
+func (s *ChatServer) watchAccess(sub *subscriber, roomID string) {
+	ticker := time.NewTicker(s.cfg.AccessCheckInterval)
+	defer ticker.Stop()
+	ctx := sub.stream.Context()
+
+	for {
+		select {
+		case <-sub.done:
+			return
+		case <-ticker.C:
+		}
+
+		if err := user.CheckSession(ctx); err != nil {
+			if status.Code(err) == codes.Unauthenticated {
+				log.Printf("세션이 끊긴 '%s' 님의 연결을 닫습니다.", sub.username)
+				sub.close(err)
+				return
+			}
+			log.Printf("세션 확인 실패 (%s): %v", sub.username, err)
+			continue
+		}
+
+		isMember, err := s.chatRepo.IsRoomMember(ctx, roomID, sub.username)
+		if err != nil {
+			log.Printf("DB Error: 방 멤버 확인 실패 (%s): %v", sub.username, err)
+			continue
+		}
+		if !isMember {
+			log.Printf("방(%s)에서 빠진 '%s' 님의 연결을 닫습니다.", roomID, sub.username)
+			sub.close(errRemovedFromRoom)
+			return
+		}
+	}
+}
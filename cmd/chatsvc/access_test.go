@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/user"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// revocableSessions: revoke 전까지 모든 세션을 살아 있는 것으로 보는 SessionChecker
+type revocableSessions struct {
+	mu      sync.Mutex
+	revoked map[string]bool
+}
+
+func (s *revocableSessions) SessionActive(ctx context.Context, sessionID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.revoked[sessionID], nil
+}
+
+func (s *revocableSessions) revoke(sessionID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.revoked[sessionID] = true
+}
+
+func configureRevocableSessions(t *testing.T) *revocableSessions {
+	t.Helper()
+	sessions := &revocableSessions{revoked: make(map[string]bool)}
+	user.ConfigureSessions(sessions)
+	t.Cleanup(func() { user.ConfigureSessions(nil) })
+	return sessions
+}
+
+// 접속 중에 세션이 끊기거나(차단/강제 로그아웃) 방에서 빠지면(관리자가 내보냄) 열려 있던 스트림도 닫혀야 함
+func TestOpenStreamIsClosedWhenAccessIsRevoked(t *testing.T) {
+	sessions := configureRevocableSessions(t)
+	cfg := config.Default().ChatService
+	cfg.AccessCheckInterval = 20 * time.Millisecond
+	repo := newMemRepo("alice", "bob")
+	repo.setGroup("group", "alice", "bob")
+	_, client := startChatServer(t, cfg, repo, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tests := []struct {
+		name, username string
+		revoke         func()
+		want           codes.Code
+	}{
+		{"session revoked", "alice", func() { sessions.revoke("s-alice") }, codes.Unauthenticated},
+		{"removed without notice", "bob", func() { repo.RemoveRoomMember(ctx, "group", "bob") }, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		stream := join(t, ctx, client, tt.username, "group")
+		recvUntil(t, stream, func(ev *chatpb.ChatEvent) bool { return ev.GetPresence() != nil })
+		tt.revoke()
+		if _, err := recvErr(stream); status.Code(err) != tt.want {
+			t.Errorf("%s: stream err = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+// 주기 확인 전이라도 끊긴 세션으로 보낸 메시지는 저장되지 않아야 함
+func TestMessageFromRevokedSessionIsRefused(t *testing.T) {
+	sessions := configureRevocableSessions(t)
+	repo := newMemRepo("alice")
+	_, client := startChatServer(t, config.Default().ChatService, repo, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream := join(t, ctx, client, "alice", "room")
+	recvUntil(t, stream, func(ev *chatpb.ChatEvent) bool { return ev.GetPresence() != nil })
+	sessions.revoke("s-alice")
+	if err := stream.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: &chatpb.ChatMessage{
+		Message: "after logout", ClientMessageId: "c1",
+	}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := recvErr(stream)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("stream err = %v, want Unauthenticated", err)
+	}
+	for _, ev := range events {
+		if ev.GetAck() != nil || ev.GetMessage() != nil {
+			t.Fatalf("revoked session got %v", ev)
+		}
+	}
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if len(repo.messages) != 0 {
+		t.Fatalf("stored %v, want nothing from a revoked session", repo.messages)
+	}
+}
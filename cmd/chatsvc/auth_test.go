@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/user"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// joinErr: 입장 핸드셰이크를 보내고 스트림이 끝날 때의 에러를 돌려줌 (입장에 성공하면 nil)
+func joinErr(ctx context.Context, client chatpb.ChatServiceClient, handshake *chatpb.ChatMessage) error {
+	stream, err := client.JoinChat(ctx)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: handshake}}); err != nil {
+		return err
+	}
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if ev.GetPresence() != nil {
+			return nil
+		}
+	}
+}
+
+func withAuthorization(ctx context.Context, value string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", value)
+}
+
+// JoinChat과 unary RPC 모두 UserService가 발급한 JWT로만 들어올 수 있고, 유저명은 토큰 기준이어야 함
+func TestChatServiceRequiresAccessToken(t *testing.T) {
+	_, client := startChatServer(t, config.Default().ChatService, newMemRepo("alice", "bob"), nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// 다른 비밀 키로 서명한 토큰
+	t.Setenv("JWT_SECRET", "some-other-secret")
+	forged, err := user.GenerateAccessToken(&user.User{ID: "id-alice", Username: "alice"}, "s-alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("JWT_SECRET", "chatsvc-test-secret")
+
+	room := &chatpb.ChatMessage{Roomid: "room"}
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		handshake *chatpb.ChatMessage
+		want      codes.Code
+	}{
+		{"no token", ctx, room, codes.Unauthenticated},
+		{"malformed token", withAuthorization(ctx, "Bearer not-a-jwt"), room, codes.Unauthenticated},
+		{"forged token", withAuthorization(ctx, "Bearer "+forged), room, codes.Unauthenticated},
+		{"username of someone else", asUser(t, ctx, "alice"), &chatpb.ChatMessage{Roomid: "room", Username: "bob"}, codes.PermissionDenied},
+		{"valid token", asUser(t, ctx, "alice"), room, codes.OK},
+	}
+	for _, tt := range tests {
+		if err := joinErr(tt.ctx, client, tt.handshake); status.Code(err) != tt.want {
+			t.Errorf("JoinChat %s: err = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	if _, err := client.GetMyRooms(ctx, &chatpb.GetMyRoomsRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("GetMyRooms without token: err = %v, want Unauthenticated", err)
+	}
+	if _, err := client.GetMyRooms(asUser(t, ctx, "alice"), &chatpb.GetMyRoomsRequest{UserId: "bob"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetMyRooms for another user: err = %v, want PermissionDenied", err)
+	}
+	if _, err := client.GetRoomID(asUser(t, ctx, "alice"), &chatpb.GetRoomIDRequest{MyId: "bob", OtherId: "alice"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetRoomID as another user: err = %v, want PermissionDenied", err)
+	}
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// 브로커 발행 타임아웃
+const publishTimeout = 5 * time.Second
+
+// 클라이언트가 읽지 않아 남은 이벤트를 보내지 못하고 끝낼 때 돌려주는 상태
+var errWriteStalled = status.Error(codes.DeadlineExceeded, "client stopped reading the stream")
+
+// JoinChat이 끝날 때 writeLoop가 끝나기를 기다리는 최대 시간
+// 읽지 않는 클라이언트에게 Send가 막혀 있으면 핸들러를 반환해야 스트림이 취소되어 풀림
+const writeLoopJoinTimeout = 10 * time.Second
+
+// subscriber: JoinChat으로 이 인스턴스의 방에 접속한 스트림 하나
+// 스트림의 Send는 writeLoop goroutine에서만 호출하고, 다른 곳에서는 enqueue로 큐에 넣기만 함
+// 큐 크기는 chatsvc.send_queue_size (넘치면 느린 접속자로 보고 연결을 끊음)
+type subscriber struct {
+	id       string // 브로커를 거쳐도 구분할 수 있는 랜덤 ID (except 처리용)
+	stream   chatpb.ChatService_JoinChatServer
+	userID   string
+	username string
+
+	replayed map[string]bool // 입장할 때 기록으로 보낸 메시지 ID (writeLoop만 씀, 실시간 이벤트 중복 제거용)
+
+	queue      chan *chatpb.ChatEvent
+	done       chan struct{} // 연결을 끝낼 때 닫힘
+	closeOnce  sync.Once
+	err        error         // done이 닫힌 이유 (정상 종료면 nil)
+	finishing  chan struct{} // 클라이언트가 송신을 끝냈을 때 닫힘 (큐에 남은 이벤트를 보낸 뒤 종료)
+	finishOnce sync.Once
+	written    chan struct{} // writeLoop가 끝나면 닫힘 (이후에는 스트림에 Send하지 않음)
+	waitOnce   sync.Once
+}
+
+func newSubscriber(stream chatpb.ChatService_JoinChatServer, userID, username string, queueSize int) *subscriber {
+	buf := make([]byte, 16)
+	rand.Read(buf)
+	return &subscriber{
+		id:        hex.EncodeToString(buf),
+		stream:    stream,
+		userID:    userID,
+		username:  username,
+		queue:     make(chan *chatpb.ChatEvent, queueSize),
+		done:      make(chan struct{}),
+		finishing: make(chan struct{}),
+		written:   make(chan struct{}),
+	}
+}
+
+// enqueue: 이벤트를 송신 큐에 넣고 바로 반환 (절대 막히지 않음)
+// 큐가 꽉 차면 방 전체가 기다리지 않도록 이 접속자를 ResourceExhausted로 끊음
+func (sub *subscriber) enqueue(ev *chatpb.ChatEvent) bool {
+	select {
+	case <-sub.done:
+		return false
+	default:
+	}
+
+	select {
+	case sub.queue <- ev:
+		return true
+	default:
+		log.Printf("송신 큐 초과로 연결을 끊습니다 (%s, %d개 대기)", sub.username, len(sub.queue))
+		sendQueueEvictions.Add(1)
+		sub.close(status.Errorf(codes.ResourceExhausted, "send queue overflow (%d events): client is not reading fast enough", cap(sub.queue)))
+		return false
+	}
+}
+
+// close: 연결 종료 표시 (여러 번 불러도 처음 이유만 남음)
+func (sub *subscriber) close(err error) {
+	sub.closeOnce.Do(func() {
+		sub.err = err
+		close(sub.done)
+	})
+}
+
+// finish: 지금까지 큐에 쌓인 이벤트(ack 등)를 모두 보낸 뒤 정상 종료하도록 표시
+func (sub *subscriber) finish() {
+	sub.finishOnce.Do(func() { close(sub.finishing) })
+}
+
+// writeLoop: 큐에 쌓인 이벤트를 순서대로 스트림에 씀
+func (sub *subscriber) writeLoop() {
+	defer close(sub.written)
+	for {
+		select {
+		case <-sub.done:
+			return
+		case ev := <-sub.queue:
+			if !sub.write(ev) {
+				return
+			}
+		case <-sub.finishing:
+			// 큐가 빌 때까지 보내고 끝냄
+			for {
+				select {
+				case <-sub.done:
+					return
+				case ev := <-sub.queue:
+					if !sub.write(ev) {
+						return
+					}
+				default:
+					sub.close(nil)
+					return
+				}
+			}
+		}
+	}
+}
+
+// write: 이벤트 하나를 스트림에 씀 (스트림을 끝내야 하면 false)
+func (sub *subscriber) write(ev *chatpb.ChatEvent) bool {
+	// nil은 goAway가 넣은 종료 표시 (앞의 이벤트를 모두 보낸 뒤 스트림을 끝냄)
+	if ev == nil {
+		sub.close(errServerGoingAway)
+		return false
+	}
+	if m := ev.GetMessage(); m != nil && sub.replayed[m.MessageId] {
+		delete(sub.replayed, m.MessageId)
+		return true
+	}
+	if err := sub.stream.Send(ev); err != nil {
+		log.Printf("스트림 전송 실패 (%s): %v", sub.username, err)
+		sub.close(err)
+		return false
+	}
+	return true
+}
+
+// waitWritten: writeLoop가 끝날 때까지 기다림 (최대 writeLoopJoinTimeout, 두 번째 호출부터는 바로 반환)
+// 기다린 뒤에는 sub가 항상 닫혀 있음
+func (sub *subscriber) waitWritten() {
+	sub.waitOnce.Do(func() {
+		select {
+		case <-sub.written:
+		case <-time.After(writeLoopJoinTimeout):
+			log.Printf("송신이 %s 동안 끝나지 않아 스트림을 닫습니다 (%s)", writeLoopJoinTimeout, sub.username)
+			sub.close(errWriteStalled)
+		}
+	})
+}
+
+// 브로커 payload 형식: "<받지 않을 subscriber ID (없으면 빈 값)>\n<proto로 직렬화한 ChatEvent>"
+func encodeBrokerPayload(except string, ev *chatpb.ChatEvent) ([]byte, error) {
+	event, err := proto.Marshal(ev)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(except+"\n"), event...), nil
+}
+
+func decodeBrokerPayload(payload []byte) (string, *chatpb.ChatEvent, error) {
+	except, event, ok := bytes.Cut(payload, []byte("\n"))
+	if !ok {
+		return "", nil, errors.New("missing header")
+	}
+	ev := &chatpb.ChatEvent{}
+	if err := proto.Unmarshal(event, ev); err != nil {
+		return "", nil, err
+	}
+	return string(except), ev, nil
+}
+
+// addSubscriber: 방에 스트림을 등록 (이 방의 첫 로컬 접속자면 브로커 구독 시작)
+// 같은 유저의 첫 연결인지와, 등록 후 접속 중인 유저명 목록을 돌려줌
+// 접속자 목록은 이 인스턴스 기준임. 서버가 종료 중이면 등록하지 않고 Unavailable
+func (s *ChatServer) addSubscriber(roomID string, sub *subscriber) (bool, []string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.draining {
+		return false, nil, errServerGoingAway
+	}
+
+	if _, ok := s.roomSubs[roomID]; !ok {
+		unsubscribe, err := s.broker.Subscribe(roomID, func(payload []byte) {
+			s.deliverLocal(roomID, payload)
+		})
+		if err != nil {
+			log.Printf("브로커 구독 실패 (%s): %v", roomID, err)
+		} else {
+			s.roomSubs[roomID] = unsubscribe
+		}
+	}
+
+	firstConn := true
+	for _, c := range s.clients[roomID] {
+		if c.userID == sub.userID {
+			firstConn = false
+		}
+	}
+	s.clients[roomID] = append(s.clients[roomID], sub)
+
+	seen := map[string]bool{}
+	var online []string
+	for _, c := range s.clients[roomID] {
+		if !seen[c.username] {
+			seen[c.username] = true
+			online = append(online, c.username)
+		}
+	}
+	return firstConn, online, nil
+}
+
+// removeSubscriber: 방에서 스트림을 제거하고, 같은 유저의 마지막 연결이었는지 돌려줌
+// 방에 로컬 접속자가 없으면 브로커 구독도 해제
+func (s *ChatServer) removeSubscriber(roomID string, sub *subscriber) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lastConn := true
+	var updatedClients []*subscriber
+	for _, c := range s.clients[roomID] {
+		if c == sub {
+			continue
+		}
+		if c.userID == sub.userID {
+			lastConn = false
+		}
+		updatedClients = append(updatedClients, c)
+	}
+
+	if len(updatedClients) == 0 {
+		delete(s.clients, roomID)
+		if unsubscribe, ok := s.roomSubs[roomID]; ok {
+			unsubscribe()
+			delete(s.roomSubs, roomID)
+		}
+		log.Printf("방('%s')이 비어서 삭제되었습니다.", roomID)
+	} else {
+		s.clients[roomID] = updatedClients
+	}
+	return lastConn
+}
+
+// broadcastMessage: 브로커를 통해 방의 모든 접속자(다른 인스턴스 포함)에게 이벤트 전달
+// except는 제외, nil이면 전원
+// 여기서는 절대 DB에 저장하지 않음 - 채팅 메시지 저장은 saveAndBroadcast에서만
+func (s *ChatServer) broadcastMessage(roomID string, ev *chatpb.ChatEvent, except *subscriber) {
+	var exceptID string
+	if except != nil {
+		exceptID = except.id
+	}
+	payload, err := encodeBrokerPayload(exceptID, ev)
+	if err != nil {
+		log.Printf("브로드캐스트 직렬화 실패: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+	if err := s.broker.Publish(ctx, roomID, payload); err != nil {
+		log.Printf("브로드캐스트 발행 실패 (%s): %v", roomID, err)
+	}
+}
+
+// deliverLocal: 브로커에서 받은 이벤트를 이 인스턴스의 접속자에게 전달
+func (s *ChatServer) deliverLocal(roomID string, payload []byte) {
+	exceptID, ev, err := decodeBrokerPayload(payload)
+	if err != nil {
+		log.Printf("브로커 payload 해석 실패: %v", err)
+		return
+	}
+
+	s.mu.RLock()
+	clients := s.clients[roomID]
+	s.mu.RUnlock()
+
+	// 큐에 넣기만 하므로 느린 접속자가 있어도 다른 접속자나 브로커가 막히지 않음
+	// 방에서 내보내진 유저의 스트림은 (어느 인스턴스에 접속해 있든) 여기서 끊음
+	removed := ev.GetMemberRemoved().GetUsername()
+	for _, client := range clients {
+		if client.id == exceptID {
+			continue
+		}
+		if removed != "" && client.username == removed {
+			client.close(errRemovedFromRoom)
+			continue
+		}
+		client.enqueue(ev)
+	}
+}
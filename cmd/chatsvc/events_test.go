@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+)
+
+// 입장/퇴장/타이핑은 저장하지 않는 일회성 이벤트로 다른 접속자에게만 전달되고, 알 수 없는 이벤트는 스트림을 끊지 않고 에러 이벤트로 알려야 함
+func TestStreamEventsAreEphemeral(t *testing.T) {
+	repo := newMemRepo("alice", "bob")
+	_, client := startChatServer(t, config.Default().ChatService, repo, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	alice := join(t, ctx, client, "alice", "room")
+	recvUntil(t, alice, func(ev *chatpb.ChatEvent) bool { return ev.GetPresence() != nil })
+	bob := join(t, ctx, client, "bob", "room")
+	got := recvUntil(t, bob, func(ev *chatpb.ChatEvent) bool { return ev.GetPresence() != nil })
+	if online := got[len(got)-1].GetPresence().GetOnlineUsernames(); !slices.Contains(online, "alice") || !slices.Contains(online, "bob") {
+		t.Fatalf("presence = %v, want alice and bob online", online)
+	}
+	joined := recvUntil(t, alice, func(ev *chatpb.ChatEvent) bool { return ev.GetMemberJoined() != nil })
+	if m := joined[len(joined)-1].GetMemberJoined(); m.Username != "bob" || m.UserId != "id-bob" {
+		t.Fatalf("member_joined = %v, want bob", m)
+	}
+
+	// 타이핑: 보낸 사람의 토큰 기준 신원으로 상대에게만 전달
+	if err := alice.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Typing{Typing: &chatpb.TypingEvent{
+		IsTyping: true, UserId: "id-bob", Username: "bob",
+	}}}); err != nil {
+		t.Fatal(err)
+	}
+	typing := recvUntil(t, bob, func(ev *chatpb.ChatEvent) bool { return ev.GetTyping() != nil })
+	if ty := typing[len(typing)-1].GetTyping(); !ty.IsTyping || ty.Username != "alice" || ty.UserId != "id-alice" {
+		t.Fatalf("typing = %v, want alice typing", ty)
+	}
+
+	// 알 수 없는 이벤트 → 에러 이벤트 (연결은 유지)
+	if err := alice.Send(&chatpb.ChatClientEvent{}); err != nil {
+		t.Fatal(err)
+	}
+	errEvents := recvUntil(t, alice, func(ev *chatpb.ChatEvent) bool { return ev.GetError() != nil })
+	if e := errEvents[len(errEvents)-1].GetError(); codes.Code(e.Code) != codes.InvalidArgument {
+		t.Fatalf("error event = %v, want InvalidArgument", e)
+	}
+	for _, ev := range errEvents {
+		if ev.GetTyping() != nil {
+			t.Fatalf("sender received its own typing event: %v", ev)
+		}
+	}
+	if err := alice.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: &chatpb.ChatMessage{
+		Message: "still connected", ClientMessageId: "c1",
+	}}}); err != nil {
+		t.Fatal(err)
+	}
+	recvUntil(t, alice, func(ev *chatpb.ChatEvent) bool { return ev.GetAck() != nil })
+
+	// 퇴장
+	if err := bob.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+	left := recvUntil(t, alice, func(ev *chatpb.ChatEvent) bool { return ev.GetMemberLeft() != nil })
+	if m := left[len(left)-1].GetMemberLeft(); m.Username != "bob" {
+		t.Fatalf("member_left = %v, want bob", m)
+	}
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if len(repo.messages) != 1 || repo.messages[0].MessageContent != "still connected" {
+		t.Fatalf("stored messages = %v, want only the chat message", repo.messages)
+	}
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/user"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 방에서 내보내진 멤버의 JoinChat이 끝날 때 돌려주는 상태
+var errRemovedFromRoom = status.Error(codes.PermissionDenied, "removed from the room")
+
+// CreateGroupRoom: 그룹 채팅방 생성 (요청한 사람이 owner)
+func (s *ChatServer) CreateGroupRoom(ctx context.Context, req *chatpb.CreateGroupRoomRequest) (*chatpb.CreateGroupRoomResponse, error) {
+	myID, err := callerUsername(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+
+	memberIDs, err := s.validateMemberIDs(ctx, req.MemberIds, myID)
+	if err != nil {
+		return nil, err
+	}
+
+	roomID, err := s.chatRepo.CreateGroupRoom(ctx, title, myID, memberIDs)
+	if err != nil {
+		log.Printf("DB Error: 그룹 방 생성 실패: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create group room")
+	}
+
+	log.Printf("'%s' 님이 그룹 방 '%s'(%s)을 만들었습니다. (초대 %d명)", myID, title, roomID, len(memberIDs))
+	return &chatpb.CreateGroupRoomResponse{RoomId: roomID}, nil
+}
+
+// AddRoomMembers: 그룹 방에 멤버 초대 (방 멤버라면 누구나 가능)
+func (s *ChatServer) AddRoomMembers(ctx context.Context, req *chatpb.AddRoomMembersRequest) (*chatpb.AddRoomMembersResponse, error) {
+	myID, err := callerUsername(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.requireGroupRoom(ctx, req.RoomId, myID); err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := s.validateMemberIDs(ctx, req.MemberIds, myID)
+	if err != nil {
+		return nil, err
+	}
+	if len(memberIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "member_ids is required")
+	}
+
+	if err := s.chatRepo.AddRoomMembers(ctx, req.RoomId, memberIDs); err != nil {
+		log.Printf("DB Error: 멤버 추가 실패: %v", err)
+		return nil, status.Error(codes.Internal, "failed to add room members")
+	}
+	return &chatpb.AddRoomMembersResponse{}, nil
+}
+
+// RemoveRoomMember: 멤버 내보내기 (owner만 가능) 또는 본인이 방 나가기
+// owner는 방을 나갈 수 없음 (owner 없는 그룹 방이 남지 않도록)
+// 내보내진 멤버가 접속 중이면 그 스트림은 PermissionDenied로 끝남
+func (s *ChatServer) RemoveRoomMember(ctx context.Context, req *chatpb.RemoveRoomMemberRequest) (*chatpb.RemoveRoomMemberResponse, error) {
+	myID, err := callerUsername(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if req.MemberId == "" {
+		return nil, status.Error(codes.InvalidArgument, "member_id is required")
+	}
+	if _, err := s.requireGroupRoom(ctx, req.RoomId, myID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.chatRepo.GetRoomMembers(ctx, req.RoomId)
+	if err != nil {
+		log.Printf("DB Error: 멤버 조회 실패: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get room members")
+	}
+	isOwner := false
+	for _, m := range members {
+		if m.UserID == myID && m.Role == user.RoomRoleOwner {
+			isOwner = true
+			break
+		}
+	}
+	switch {
+	case req.MemberId == myID && isOwner:
+		return nil, status.Error(codes.FailedPrecondition, "the room owner cannot leave the room")
+	case req.MemberId != myID && !isOwner:
+		// 다른 사람을 내보내는 건 owner만 가능
+		return nil, status.Error(codes.PermissionDenied, "only the room owner can remove other members")
+	}
+
+	removed, err := s.chatRepo.RemoveRoomMember(ctx, req.RoomId, req.MemberId)
+	if err != nil {
+		log.Printf("DB Error: 멤버 제거 실패: %v", err)
+		return nil, status.Error(codes.Internal, "failed to remove room member")
+	}
+	if !removed {
+		return nil, status.Errorf(codes.NotFound, "user %s is not a member of the room", req.MemberId)
+	}
+
+	// 방 접속자에게 알리고, 내보내진 멤버의 스트림은 deliverLocal에서 끊음
+	memberUUID, _, err := s.chatRepo.GetUserInfo(ctx, req.MemberId)
+	if err != nil {
+		log.Printf("DB Error: 유저 조회 실패: %v", err)
+	}
+	s.broadcastMessage(req.RoomId, &chatpb.ChatEvent{
+		RoomId: req.RoomId,
+		Event:  &chatpb.ChatEvent_MemberRemoved{MemberRemoved: &chatpb.MemberEvent{UserId: memberUUID, Username: req.MemberId}},
+	}, nil)
+	if req.MemberId == myID {
+		log.Printf("'%s' 님이 방(%s)을 나갔습니다.", myID, req.RoomId)
+	} else {
+		log.Printf("'%s' 님이 방(%s)에서 '%s' 님을 내보냈습니다.", myID, req.RoomId, req.MemberId)
+	}
+	return &chatpb.RemoveRoomMemberResponse{}, nil
+}
+
+// RenameRoom: 그룹 방 이름 변경 (방 멤버라면 누구나 가능)
+func (s *ChatServer) RenameRoom(ctx context.Context, req *chatpb.RenameRoomRequest) (*chatpb.RenameRoomResponse, error) {
+	myID, err := callerUsername(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if _, err := s.requireGroupRoom(ctx, req.RoomId, myID); err != nil {
+		return nil, err
+	}
+
+	if err := s.chatRepo.RenameRoom(ctx, req.RoomId, title); err != nil {
+		log.Printf("DB Error: 방 이름 변경 실패: %v", err)
+		return nil, status.Error(codes.Internal, "failed to rename room")
+	}
+	return &chatpb.RenameRoomResponse{}, nil
+}
+
+// ListRoomMembers: 방 멤버 목록 (1:1 방도 가능)
+func (s *ChatServer) ListRoomMembers(ctx context.Context, req *chatpb.ListRoomMembersRequest) (*chatpb.ListRoomMembersResponse, error) {
+	myID, err := callerUsername(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireRoomMember(ctx, req.RoomId, myID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.chatRepo.GetRoomMembers(ctx, req.RoomId)
+	if err != nil {
+		log.Printf("DB Error: 멤버 조회 실패: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get room members")
+	}
+
+	resp := &chatpb.ListRoomMembersResponse{
+		Members: make([]*chatpb.RoomMember, 0, len(members)),
+	}
+	for _, m := range members {
+		resp.Members = append(resp.Members, &chatpb.RoomMember{
+			UserId:   m.UserID,
+			Role:     m.Role,
+			JoinedAt: m.JoinedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+// requireGroupRoom: 내가 멤버인 그룹 방인지 확인
+func (s *ChatServer) requireGroupRoom(ctx context.Context, roomID, userName string) (*user.RoomInfoRecord, error) {
+	if roomID == "" {
+		return nil, status.Error(codes.InvalidArgument, "room_id is required")
+	}
+	if err := s.requireRoomMember(ctx, roomID, userName); err != nil {
+		return nil, err
+	}
+
+	room, err := s.chatRepo.GetRoom(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, user.ErrRoomNotFound) {
+			return nil, status.Error(codes.NotFound, "room not found")
+		}
+		log.Printf("DB Error: 방 조회 실패: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get room")
+	}
+	if room.RoomType != user.RoomTypeGroup {
+		return nil, status.Error(codes.FailedPrecondition, "not a group room")
+	}
+	return room, nil
+}
+
+// validateMemberIDs: 중복/본인 아이디를 빼고, 모두 실제 존재하는 유저인지 확인
+func (s *ChatServer) validateMemberIDs(ctx context.Context, ids []string, myID string) ([]string, error) {
+	seen := map[string]bool{myID: true}
+	var memberIDs []string
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		exists, err := s.chatRepo.UserExists(ctx, id)
+		if err != nil {
+			log.Printf("DB Error: 유저 확인 실패: %v", err)
+			return nil, status.Error(codes.Internal, "failed to check user")
+		}
+		if !exists {
+			return nil, status.Errorf(codes.NotFound, "user %s does not exist", id)
+		}
+		memberIDs = append(memberIDs, id)
+	}
+	return memberIDs, nil
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recvErr: 스트림이 끝날 때까지 받은 이벤트와 끝난 이유를 돌려줌
+func recvErr(stream chatpb.ChatService_JoinChatClient) ([]*chatpb.ChatEvent, error) {
+	var events []*chatpb.ChatEvent
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			return events, err
+		}
+		events = append(events, ev)
+	}
+}
+
+// 내보내진 멤버는 열려 있던 스트림이 바로 끊기고 다시 들어올 수 없어야 함
+func TestRemovedMemberIsDisconnected(t *testing.T) {
+	repo := newMemRepo("alice", "bob", "carol")
+	repo.setGroup("group", "alice", "bob", "carol")
+	_, client := startChatServer(t, config.Default().ChatService, repo, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	bob := join(t, ctx, client, "bob", "group")
+	recvUntil(t, bob, func(ev *chatpb.ChatEvent) bool { return ev.GetPresence() != nil })
+	carol := join(t, ctx, client, "carol", "group")
+	recvUntil(t, carol, func(ev *chatpb.ChatEvent) bool { return ev.GetPresence() != nil })
+
+	if _, err := client.RemoveRoomMember(asUser(t, ctx, "alice"), &chatpb.RemoveRoomMemberRequest{RoomId: "group", MemberId: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := recvErr(bob); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("removed member's stream err = %v, want PermissionDenied", err)
+	}
+	recvUntil(t, carol, func(ev *chatpb.ChatEvent) bool { return ev.GetMemberRemoved().GetUsername() == "bob" })
+	if err := joinErr(asUser(t, ctx, "bob"), client, &chatpb.ChatMessage{Roomid: "group"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("rejoin after removal: err = %v, want PermissionDenied", err)
+	}
+}
+
+// 알림을 받지 못한 스트림(다른 인스턴스나 관리자가 내보낸 경우)도 메시지는 저장되지 않고 끊겨야 함
+func TestRemovedMemberCannotSend(t *testing.T) {
+	repo := newMemRepo("alice", "bob", "carol")
+	repo.setGroup("group", "alice", "bob", "carol")
+	_, client := startChatServer(t, config.Default().ChatService, repo, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	bob := join(t, ctx, client, "bob", "group")
+	recvUntil(t, bob, func(ev *chatpb.ChatEvent) bool { return ev.GetPresence() != nil })
+	carol := join(t, ctx, client, "carol", "group")
+	recvUntil(t, carol, func(ev *chatpb.ChatEvent) bool { return ev.GetPresence() != nil })
+
+	// 방 멤버에서만 빠지고 접속 중인 스트림에는 아무 알림도 가지 않은 상태
+	repo.RemoveRoomMember(ctx, "group", "bob")
+	if err := bob.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: &chatpb.ChatMessage{
+		Message: "still here?", ClientMessageId: "bob-1",
+	}}}); err != nil {
+		t.Fatal(err)
+	}
+	events, err := recvErr(bob)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("removed member's stream err = %v, want PermissionDenied", err)
+	}
+	for _, ev := range events {
+		if ev.GetAck() != nil {
+			t.Fatalf("removed member got an ack: %v", ev)
+		}
+	}
+
+	// carol에게는 bob의 메시지가 가지 않음 (carol 자신의 메시지가 먼저 오는 메시지여야 함)
+	if err := carol.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: &chatpb.ChatMessage{
+		Message: "anyone?", ClientMessageId: "carol-1",
+	}}}); err != nil {
+		t.Fatal(err)
+	}
+	got := recvUntil(t, carol, func(ev *chatpb.ChatEvent) bool { return ev.GetMessage() != nil })
+	if m := got[len(got)-1].GetMessage(); m.Username != "carol" {
+		t.Fatalf("carol received %+v, want only her own message", m)
+	}
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	for _, m := range repo.messages {
+		if m.Username == "bob" {
+			t.Fatalf("message from a removed member was stored: %+v", m)
+		}
+	}
+}
+
+func TestRemoveRoomMemberPermissions(t *testing.T) {
+	repo := newMemRepo("alice", "bob", "carol", "dave", "mallory")
+	repo.setGroup("group", "alice", "bob", "carol", "dave")
+	repo.setMembers("dm", "alice", "bob")
+	_, client := startChatServer(t, config.Default().ChatService, repo, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// 순서대로 실행 (앞의 성공한 호출이 뒤의 결과에 영향을 줌)
+	tests := []struct {
+		name, caller, roomID, member string
+		want                         codes.Code
+	}{
+		{"member removes another", "bob", "group", "carol", codes.PermissionDenied},
+		{"outsider removes a member", "mallory", "group", "bob", codes.PermissionDenied},
+		{"owner leaves", "alice", "group", "alice", codes.FailedPrecondition},
+		{"direct room", "alice", "dm", "bob", codes.FailedPrecondition},
+		{"member leaves", "carol", "group", "carol", codes.OK},
+		{"owner removes a member", "alice", "group", "bob", codes.OK},
+		{"already removed", "alice", "group", "bob", codes.NotFound},
+	}
+	for _, tt := range tests {
+		_, err := client.RemoveRoomMember(asUser(t, ctx, tt.caller), &chatpb.RemoveRoomMemberRequest{RoomId: tt.roomID, MemberId: tt.member})
+		if status.Code(err) != tt.want {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	members, _ := repo.GetRoomMembers(ctx, "group")
+	var names []string
+	for _, m := range members {
+		names = append(names, m.UserID+"/"+m.Role)
+	}
+	if len(names) != 2 || names[0] != "alice/owner" || names[1] != "dave/member" {
+		t.Fatalf("group members = %v, want alice/owner and dave/member", names)
+	}
+}
+
+func TestCreateGroupRoomValidatesMembers(t *testing.T) {
+	_, client := startChatServer(t, config.Default().ChatService, newMemRepo("alice", "bob"), nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	alice := asUser(t, ctx, "alice")
+
+	if _, err := client.CreateGroupRoom(alice, &chatpb.CreateGroupRoomRequest{Title: "  ", MemberIds: []string{"bob"}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("blank title: err = %v, want InvalidArgument", err)
+	}
+	if _, err := client.CreateGroupRoom(alice, &chatpb.CreateGroupRoomRequest{Title: "team", MemberIds: []string{"bob", "ghost"}}); status.Code(err) != codes.NotFound {
+		t.Errorf("unknown member: err = %v, want NotFound", err)
+	}
+	if _, err := client.AddRoomMembers(alice, &chatpb.AddRoomMembersRequest{RoomId: "room", MemberIds: []string{"bob"}}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("add members to a direct room: err = %v, want FailedPrecondition", err)
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/user"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetMessages: cursor 기반 메시지 기록 조회
+func (s *ChatServer) GetMessages(ctx context.Context, req *chatpb.GetMessagesRequest) (*chatpb.GetMessagesResponse, error) {
+	myID, err := callerUsername(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if req.RoomId == "" {
+		return nil, status.Error(codes.InvalidArgument, "room_id is required")
+	}
+	if err := s.requireRoomMember(ctx, req.RoomId, myID); err != nil {
+		return nil, err
+	}
+
+	var cursor *user.MessageCursor
+	if req.Cursor != "" {
+		cursor, err = user.DecodeMessageCursor(req.Cursor)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = s.cfg.HistoryPageSize
+	}
+	if limit > s.cfg.MaxHistoryPageSize {
+		limit = s.cfg.MaxHistoryPageSize
+	}
+
+	// 한 개 더 가져와서 다음 페이지가 있는지 판단
+	var records []*user.MessageRecord
+	forward := req.Direction == chatpb.PageDirection_PAGE_DIRECTION_FORWARD
+	if forward {
+		records, err = s.chatRepo.GetMessagesAfter(ctx, req.RoomId, cursor, limit+1)
+	} else {
+		records, err = s.chatRepo.GetMessagesBefore(ctx, req.RoomId, cursor, limit+1)
+	}
+	if err != nil {
+		log.Printf("DB Error: 기록 조회 실패: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get messages")
+	}
+
+	hasMore := len(records) > limit
+	if hasMore {
+		// 요청 방향의 가장 먼 메시지 하나를 버림 (FORWARD면 마지막, BACKWARD면 첫 번째)
+		if forward {
+			records = records[:limit]
+		} else {
+			records = records[1:]
+		}
+	}
+
+	resp := &chatpb.GetMessagesResponse{
+		Messages: make([]*chatpb.ChatMessage, 0, len(records)),
+		HasMore:  hasMore,
+	}
+	for _, r := range records {
+		resp.Messages = append(resp.Messages, toProtoMessage(r))
+	}
+	if len(records) > 0 {
+		resp.PrevCursor = messageCursor(records[0])
+		resp.NextCursor = messageCursor(records[len(records)-1])
+	} else {
+		// 결과가 없으면 받은 cursor를 그대로 돌려줘서 같은 위치에서 다시 요청할 수 있게 함
+		resp.PrevCursor = req.Cursor
+		resp.NextCursor = req.Cursor
+	}
+	return resp, nil
+}
+
+// replayHistory: JoinChat 입장 시 기록 전송 (writeLoop를 시작하기 전에 스트림에 직접 보냄)
+// from이 nil이면 최근 한 페이지, 아니면 from 이후 메시지를 빠짐없이 페이지 단위로 보냄
+// 보낸 메시지 ID를 돌려줌 (구독 후에 조회했으므로 같은 메시지가 실시간 이벤트로도 큐에 있을 수 있음)
+// cursor 비교로 거르지 않는 이유: 먼저 sent_at을 받은 메시지가 나중에 commit되면 마지막으로 보낸 기록보다
+// cursor가 작아도 기록에는 없으므로, 그런 메시지는 실시간 이벤트로 보내야 함
+func (s *ChatServer) replayHistory(stream chatpb.ChatService_JoinChatServer, roomID string, from *user.MessageCursor) (map[string]bool, error) {
+	ctx := stream.Context()
+	replayed := make(map[string]bool)
+
+	for {
+		var records []*user.MessageRecord
+		var err error
+		if from == nil {
+			records, err = s.chatRepo.GetMessagesBefore(ctx, roomID, nil, s.cfg.HistoryPageSize)
+		} else {
+			records, err = s.chatRepo.GetMessagesAfter(ctx, roomID, from, s.cfg.HistoryPageSize)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}
+			log.Printf("DB Error: 기록 조회 실패: %v", err)
+			return nil, status.Error(codes.Internal, "failed to load chat history")
+		}
+		for _, r := range records {
+			if err := stream.Send(messageEvent(r)); err != nil {
+				return nil, err
+			}
+			replayed[r.ID] = true
+		}
+		// 처음 입장은 최근 한 페이지만
+		if from == nil || len(records) < s.cfg.HistoryPageSize {
+			return replayed, nil
+		}
+		from = user.CursorOf(records[len(records)-1])
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetMessagesPagesWithCursor(t *testing.T) {
+	repo := newMemRepo("alice", "bob")
+	for _, text := range []string{"one", "two", "three", "four", "five"} {
+		repo.SaveMessage(context.Background(), "room", "id-bob", "bob", text, "")
+	}
+	_, client := startChatServer(t, config.Default().ChatService, repo, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	alice := asUser(t, ctx, "alice")
+
+	// 커서 없이 BACKWARD면 최신 페이지부터, 받은 prev_cursor로 더 오래된 페이지를 이어서 받음
+	var backward []string
+	for cursor, more := "", true; more; {
+		resp, err := client.GetMessages(alice, &chatpb.GetMessagesRequest{RoomId: "room", Cursor: cursor, Limit: 2})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var page []string
+		for _, m := range resp.Messages {
+			page = append(page, m.Message)
+		}
+		backward = append(page, backward...)
+		cursor, more = resp.PrevCursor, resp.HasMore
+	}
+	want := []string{"one", "two", "three", "four", "five"}
+	if !slices.Equal(backward, want) {
+		t.Fatalf("backward pages = %v, want %v", backward, want)
+	}
+
+	// FORWARD는 가장 오래된 페이지부터 next_cursor로 이어짐
+	var forward []string
+	for cursor, more := "", true; more; {
+		resp, err := client.GetMessages(alice, &chatpb.GetMessagesRequest{
+			RoomId: "room", Cursor: cursor, Limit: 2, Direction: chatpb.PageDirection_PAGE_DIRECTION_FORWARD,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range resp.Messages {
+			forward = append(forward, m.Message)
+		}
+		cursor, more = resp.NextCursor, resp.HasMore
+	}
+	if !slices.Equal(forward, want) {
+		t.Fatalf("forward pages = %v, want %v", forward, want)
+	}
+}
+
+// 잘못된 cursor는 DB까지 가지 않고 InvalidArgument로 거부되어야 함
+func TestGetMessagesRejectsInvalidCursor(t *testing.T) {
+	_, client := startChatServer(t, config.Default().ChatService, newMemRepo("alice"), nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	raw := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+	for _, cursor := range []string{"%%%", raw("1714554000000000"), raw("yesterday:" + msgID(1)), raw("1714554000000000:not-a-uuid")} {
+		if _, err := client.GetMessages(asUser(t, ctx, "alice"), &chatpb.GetMessagesRequest{RoomId: "room", Cursor: cursor}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GetMessages(cursor=%q): err = %v, want InvalidArgument", cursor, err)
+		}
+		if err := joinErr(asUser(t, ctx, "alice"), client, &chatpb.ChatMessage{Roomid: "room", ResumeCursor: cursor}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("JoinChat(resume_cursor=%q): err = %v, want InvalidArgument", cursor, err)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/broker"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/db"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/user"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+)
+
+// 허용하는 가장 긴 메시지도 Postgres 브로커의 NOTIFY 제한 안에 들어가야 함
+func TestMaxMessageFitsBrokerPayload(t *testing.T) {
+	roomID := "6f1c2a9e-5b1d-4c43-9a53-2b0c8f6f7a11"
+	ev := messageEvent(&user.MessageRecord{
+		ID:             "0b8f3c52-1d7e-4f0e-a3c1-6c1d2e3f4a5b",
+		RoomID:         roomID,
+		SenderID:       "2c9a7e41-8b3d-4e6f-9a1b-3c4d5e6f7a8b",
+		Username:       strings.Repeat("u", 64),
+		MessageContent: strings.Repeat("가", maxMessageBytes/len("가")),
+		SentAt:         time.Now(),
+		ClientMsgID:    strings.Repeat("c", 64),
+	})
+
+	payload, err := encodeBrokerPayload("8d7c6b5a-4f3e-4d2c-9b1a-0f9e8d7c6b5a", ev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if limit := broker.MaxPayloadSize(roomID); len(payload) > limit {
+		t.Fatalf("broker payload for a %d byte message is %d bytes, over the %d byte limit", maxMessageBytes, len(payload), limit)
+	}
+}
+
+// 같은 Postgres를 쓰는 두 인스턴스: 한쪽에 접속한 유저가 보낸 메시지를 다른 쪽 접속자가 받아야 함
+func TestChatServersShareRoomsThroughPostgres(t *testing.T) {
+	url := os.Getenv("DATABASE_URL")
+	if url == "" {
+		t.Skip("DATABASE_URL is not set")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Close()
+	if err := db.ApplyMigrations(ctx, pool); err != nil {
+		t.Fatal(err)
+	}
+
+	// 공유 DB에서도 안전하도록 임의 이름의 유저/방을 만들고 끝나면 지움
+	suffix := make([]byte, 6)
+	rand.Read(suffix)
+	alice, bob := "alice_"+hex.EncodeToString(suffix), "bob_"+hex.EncodeToString(suffix)
+	ids := map[string]string{}
+	for _, name := range []string{alice, bob} {
+		var id string
+		if err := pool.QueryRow(ctx,
+			`INSERT INTO users (username, name, email, password_hash) VALUES ($1, $1, $1 || '@example.test', 'x') RETURNING id::text`,
+			name).Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+		ids[name] = id
+	}
+	repo := user.NewChatRepository(pool)
+	roomID, err := repo.EnsureDirectRoom(ctx, alice, bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		ctx := context.Background()
+		pool.Exec(ctx, `DELETE FROM messages WHERE room_id = $1`, roomID)
+		pool.Exec(ctx, `DELETE FROM room_members WHERE room_id = $1`, roomID)
+		pool.Exec(ctx, `DELETE FROM rooms WHERE room_id = $1`, roomID)
+		pool.Exec(ctx, `DELETE FROM users WHERE username = ANY($1)`, []string{alice, bob})
+	})
+
+	brokerA, brokerB := broker.NewPostgres(pool), broker.NewPostgres(pool)
+	defer brokerA.Close()
+	defer brokerB.Close()
+	waitListening(t, brokerB, brokerA)
+	cfg := config.Default().ChatService
+	_, clientA := startChatServer(t, cfg, repo, brokerA)
+	_, clientB := startChatServer(t, cfg, repo, brokerB)
+
+	aliceStream := join(t, asUserID(t, ctx, ids[alice], alice), clientA, alice, roomID)
+	bobStream := join(t, asUserID(t, ctx, ids[bob], bob), clientB, bob, roomID)
+	// alice는 bob의 입장 알림을 받으면 인스턴스 B에서 온 이벤트를 받을 수 있는 상태
+	recvUntil(t, aliceStream, func(ev *chatpb.ChatEvent) bool { return ev.GetMemberJoined().GetUsername() == bob })
+
+	if err := bobStream.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: &chatpb.ChatMessage{
+		Message: "hello from instance B", ClientMessageId: "cross-instance-1",
+	}}}); err != nil {
+		t.Fatal(err)
+	}
+	events := recvUntil(t, aliceStream, func(ev *chatpb.ChatEvent) bool { return ev.GetMessage() != nil })
+	got := events[len(events)-1].GetMessage()
+	if got.Message != "hello from instance B" || got.Username != bob || got.SenderId != ids[bob] {
+		t.Fatalf("alice received %+v", got)
+	}
+}
+
+// waitListening: sub의 LISTEN 커넥션이 준비될 때까지 pub으로 임시 방에 알림을 반복 발행
+func waitListening(t *testing.T, pub, sub broker.Broker) {
+	t.Helper()
+	roomID := "warmup-" + rand.Text()
+	got := make(chan struct{}, 1)
+	unsubscribe, err := sub.Subscribe(roomID, func([]byte) {
+		select {
+		case got <- struct{}{}:
+		default:
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unsubscribe()
+
+	deadline := time.After(15 * time.Second)
+	for {
+		if err := pub.Publish(context.Background(), roomID, []byte("ping")); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case <-got:
+			return
+		case <-time.After(100 * time.Millisecond):
+		case <-deadline:
+			t.Fatal("broker never started listening")
+		}
+	}
+}
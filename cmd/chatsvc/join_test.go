@@ -0,0 +1,443 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/broker"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/user"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// memRepo: JoinChat / 메시지 저장에 필요한 만큼만 구현한 인메모리 ChatRepository
+// 등록된 유저는 members에 없는 모든 방의 멤버로 취급함 (나머지 메서드는 nil 임베드라 부르면 panic)
+type memRepo struct {
+	user.ChatRepository
+
+	mu       sync.Mutex
+	users    map[string]bool
+	members  map[string]map[string]bool     // 방 ID → 멤버 유저명 (setMembers로 등록한 방만)
+	owners   map[string]string              // 그룹 방 ID → owner 유저명 (setGroup으로 등록한 방만)
+	messages []*user.MessageRecord          // sent_at 순
+	reads    map[string]*user.MessageCursor // "방 ID/유저명" → 읽음 위치
+
+	// 기록 조회 전/후에 부르는 훅 (조회와 동시에 다른 인스턴스가 저장하는 상황 재현용)
+	beforeHistory, afterHistory func(ctx context.Context)
+	historyErr                  error
+
+	// 메시지 저장 전에 부르는 훅 (저장이 진행 중인 상황 재현용)
+	beforeSave func(ctx context.Context)
+}
+
+func newMemRepo(usernames ...string) *memRepo {
+	r := &memRepo{users: make(map[string]bool), members: make(map[string]map[string]bool), owners: make(map[string]string), reads: make(map[string]*user.MessageCursor)}
+	for _, u := range usernames {
+		r.users[u] = true
+	}
+	return r
+}
+
+func (r *memRepo) UserExists(ctx context.Context, username string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.users[username], nil
+}
+
+// setMembers: roomID의 멤버를 usernames로 한정
+func (r *memRepo) setMembers(roomID string, usernames ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.members[roomID] = make(map[string]bool)
+	for _, u := range usernames {
+		r.members[roomID][u] = true
+	}
+}
+
+// setGroup: roomID를 owner가 있는 그룹 방으로 등록
+func (r *memRepo) setGroup(roomID, owner string, members ...string) {
+	r.setMembers(roomID, append([]string{owner}, members...)...)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.owners[roomID] = owner
+}
+
+// isMember: r.mu를 잡은 상태에서 부름
+func (r *memRepo) isMember(roomID, username string) bool {
+	if members, ok := r.members[roomID]; ok {
+		return members[username]
+	}
+	return r.users[username]
+}
+
+func (r *memRepo) IsRoomMember(ctx context.Context, roomID, userID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.isMember(roomID, userID), nil
+}
+
+func (r *memRepo) GetUserInfo(ctx context.Context, username string) (string, time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.users[username] {
+		return "", time.Time{}, errors.New("no such user")
+	}
+	return "id-" + username, time.Time{}, nil
+}
+
+func (r *memRepo) GetRoom(ctx context.Context, roomID string) (*user.RoomInfoRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	room := &user.RoomInfoRecord{RoomID: roomID, RoomType: user.RoomTypeDirect, MemberCount: len(r.members[roomID])}
+	if _, ok := r.owners[roomID]; ok {
+		room.RoomType = user.RoomTypeGroup
+	}
+	return room, nil
+}
+
+func (r *memRepo) GetRoomMembers(ctx context.Context, roomID string) ([]*user.RoomMemberRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var members []*user.RoomMemberRecord
+	for name := range r.members[roomID] {
+		role := user.RoomRoleMember
+		if r.owners[roomID] == name {
+			role = user.RoomRoleOwner
+		}
+		members = append(members, &user.RoomMemberRecord{RoomID: roomID, UserID: name, Role: role})
+	}
+	slices.SortFunc(members, func(a, b *user.RoomMemberRecord) int { return strings.Compare(a.UserID, b.UserID) })
+	return members, nil
+}
+
+func (r *memRepo) RemoveRoomMember(ctx context.Context, roomID, userID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.members[roomID][userID] {
+		return false, nil
+	}
+	delete(r.members[roomID], userID)
+	return true, nil
+}
+
+func (r *memRepo) SaveMessage(ctx context.Context, roomID, senderID, username, messageContent, clientMsgID string) (*user.MessageRecord, bool, error) {
+	if r.beforeSave != nil {
+		r.beforeSave(ctx)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.isMember(roomID, username) {
+		return nil, false, user.ErrNotRoomMember
+	}
+	for _, m := range r.messages {
+		if clientMsgID != "" && m.ClientMsgID == clientMsgID {
+			return m, true, nil
+		}
+	}
+	n := len(r.messages) + 1
+	record := &user.MessageRecord{
+		ID:             msgID(n),
+		RoomID:         roomID,
+		SenderID:       senderID,
+		Username:       username,
+		MessageContent: messageContent,
+		SentAt:         time.Date(2024, 5, 1, 9, 0, n, 0, time.UTC),
+		ClientMsgID:    clientMsgID,
+	}
+	r.messages = append(r.messages, record)
+	return record, false, nil
+}
+
+func (r *memRepo) GetMessageByID(ctx context.Context, roomID, messageID string) (*user.MessageRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, m := range r.messages {
+		if m.RoomID == roomID && m.ID == messageID {
+			return m, nil
+		}
+	}
+	return nil, user.ErrMessageNotFound
+}
+
+func (r *memRepo) UpdateReadCursor(ctx context.Context, roomID, userID string, cursor *user.MessageCursor) (string, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	current := r.reads[roomID+"/"+userID]
+	if current != nil && !after(&user.MessageRecord{SentAt: cursor.SentAt, ID: cursor.ID}, current) {
+		return current.ID, false, nil
+	}
+	r.reads[roomID+"/"+userID] = cursor
+	return cursor.ID, true, nil
+}
+
+func (r *memRepo) CountUnread(ctx context.Context, roomID, userID string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, m := range r.messages {
+		if m.RoomID == roomID && m.Username != userID && after(m, r.reads[roomID+"/"+userID]) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// GetRoomsByUser: setMembers로 등록한 방 중 userID가 멤버인 방 (마지막 메시지 시각순, 메시지가 없는 방은 맨 뒤)
+func (r *memRepo) GetRoomsByUser(ctx context.Context, userID string, after *user.RoomCursor, limit int) ([]*user.RoomInfoRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var rooms []*user.RoomInfoRecord
+	for roomID, members := range r.members {
+		if !members[userID] {
+			continue
+		}
+		room := &user.RoomInfoRecord{RoomID: roomID, RoomType: user.RoomTypeDirect, MemberCount: len(members)}
+		for _, m := range r.messages {
+			if m.RoomID == roomID {
+				room.LastMessageID, room.LastMessagePreview, room.LastMessageUsername = m.ID, m.MessageContent, m.Username
+				room.LastMessageAt, room.LastActivityAt = m.SentAt, m.SentAt
+			}
+		}
+		rooms = append(rooms, room)
+	}
+	slices.SortFunc(rooms, func(a, b *user.RoomInfoRecord) int {
+		if c := b.LastActivityAt.Compare(a.LastActivityAt); c != 0 {
+			return c
+		}
+		return strings.Compare(b.RoomID, a.RoomID)
+	})
+	if after != nil {
+		rooms = slices.DeleteFunc(rooms, func(room *user.RoomInfoRecord) bool {
+			older := room.LastActivityAt.Before(after.LastActivityAt) ||
+				(room.LastActivityAt.Equal(after.LastActivityAt) && room.RoomID < after.RoomID)
+			return !older
+		})
+	}
+	return rooms[:min(limit, len(rooms))], nil
+}
+
+// msgID: memRepo가 n번째로 저장한 메시지의 ID (실제 DB처럼 UUID 형식)
+func msgID(n int) string {
+	return fmt.Sprintf("00000000-0000-0000-0000-%012d", n)
+}
+
+// history: 방의 메시지를 오래된 순으로 (훅 포함)
+func (r *memRepo) history(ctx context.Context, roomID string, keep func(*user.MessageRecord) bool) ([]*user.MessageRecord, error) {
+	if r.beforeHistory != nil {
+		r.beforeHistory(ctx)
+	}
+	r.mu.Lock()
+	var out []*user.MessageRecord
+	for _, m := range r.messages {
+		if m.RoomID == roomID && keep(m) {
+			out = append(out, m)
+		}
+	}
+	err := r.historyErr
+	r.mu.Unlock()
+	if r.afterHistory != nil {
+		r.afterHistory(ctx)
+	}
+	return out, err
+}
+
+func after(m *user.MessageRecord, c *user.MessageCursor) bool {
+	return c == nil || m.SentAt.After(c.SentAt) || (m.SentAt.Equal(c.SentAt) && m.ID > c.ID)
+}
+
+func (r *memRepo) GetMessagesBefore(ctx context.Context, roomID string, cursor *user.MessageCursor, limit int) ([]*user.MessageRecord, error) {
+	out, err := r.history(ctx, roomID, func(m *user.MessageRecord) bool {
+		return cursor == nil || (!after(m, cursor) && m.ID != cursor.ID)
+	})
+	return out[max(0, len(out)-limit):], err
+}
+
+func (r *memRepo) GetMessagesAfter(ctx context.Context, roomID string, cursor *user.MessageCursor, limit int) ([]*user.MessageRecord, error) {
+	out, err := r.history(ctx, roomID, func(m *user.MessageRecord) bool { return after(m, cursor) })
+	return out[:min(limit, len(out))], err
+}
+
+// startChatServer: 인증 인터셉터가 붙은 ChatServer를 bufconn으로 띄우고 클라이언트를 돌려줌 (b가 nil이면 인메모리 브로커)
+func startChatServer(t *testing.T, cfg config.ChatServiceConfig, repo user.ChatRepository, b broker.Broker, opts ...grpc.ServerOption) (*ChatServer, chatpb.ChatServiceClient) {
+	t.Helper()
+	if b == nil {
+		b = broker.NewMemory()
+	}
+	s := NewChatServer(cfg, repo, b)
+	_, client := serveChat(t, s, opts...)
+	return s, client
+}
+
+// serveChat: s를 인증 인터셉터가 붙은 gRPC 서버로 bufconn에 띄움
+func serveChat(t *testing.T, s *ChatServer, opts ...grpc.ServerOption) (*grpc.Server, chatpb.ChatServiceClient) {
+	t.Helper()
+	t.Setenv("JWT_SECRET", "chatsvc-test-secret")
+
+	srv := grpc.NewServer(append(opts,
+		grpc.UnaryInterceptor(user.UnaryAuthInterceptor),
+		grpc.StreamInterceptor(user.StreamAuthInterceptor),
+	)...)
+	chatpb.RegisterChatServiceServer(srv, s)
+
+	lis := bufconn.Listen(1 << 20)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return srv, chatpb.NewChatServiceClient(conn)
+}
+
+// asUser: username(유저 ID는 "id-<username>")의 access token을 붙인 context
+func asUser(t *testing.T, ctx context.Context, username string) context.Context {
+	t.Helper()
+	return asUserID(t, ctx, "id-"+username, username)
+}
+
+func asUserID(t *testing.T, ctx context.Context, userID, username string) context.Context {
+	t.Helper()
+	token, err := user.GenerateAccessToken(&user.User{ID: userID, Username: username}, "s-"+username)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+// join: roomID에 입장 핸드셰이크를 보낸 JoinChat 스트림 (ctx에 토큰이 없으면 asUser로 붙임)
+func join(t *testing.T, ctx context.Context, client chatpb.ChatServiceClient, username, roomID string) chatpb.ChatService_JoinChatClient {
+	t.Helper()
+	if md, _ := metadata.FromOutgoingContext(ctx); len(md.Get("authorization")) == 0 {
+		ctx = asUser(t, ctx, username)
+	}
+	stream, err := client.JoinChat(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: &chatpb.ChatMessage{Roomid: roomID}}}); err != nil {
+		t.Fatal(err)
+	}
+	return stream
+}
+
+// recvUntil: ok가 true인 이벤트가 올 때까지 받은 이벤트를 모두 돌려줌
+func recvUntil(t *testing.T, stream chatpb.ChatService_JoinChatClient, ok func(*chatpb.ChatEvent) bool) []*chatpb.ChatEvent {
+	t.Helper()
+	var events []*chatpb.ChatEvent
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv after %d events: %v", len(events), err)
+		}
+		events = append(events, ev)
+		if ok(ev) {
+			return events
+		}
+	}
+}
+
+func messageIDs(events []*chatpb.ChatEvent) []string {
+	var ids []string
+	for _, ev := range events {
+		if m := ev.GetMessage(); m != nil {
+			ids = append(ids, m.MessageId)
+		}
+	}
+	return ids
+}
+
+// 기록을 조회하는 동안 다른 인스턴스가 저장한 메시지도 빠짐없이, 한 번씩만 받아야 함
+func TestJoinChatDeliversMessagesSavedDuringReplay(t *testing.T) {
+	repo := newMemRepo("alice", "bob")
+	s, client := startChatServer(t, config.Default().ChatService, repo, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	saveAndPublish := func(text string) {
+		record, _, _ := repo.SaveMessage(ctx, "room", "id-bob", "bob", text, "")
+		s.broadcastMessage("room", messageEvent(record), nil)
+	}
+	saveAndPublish("before join")
+	// 조회 직전에 commit된 메시지는 기록과 실시간 이벤트 양쪽에 나오고, 조회 직후 commit된 메시지는 실시간 이벤트로만 나옴
+	var beforeOnce, afterOnce sync.Once
+	repo.beforeHistory = func(context.Context) { beforeOnce.Do(func() { saveAndPublish("during replay (in history)") }) }
+	repo.afterHistory = func(context.Context) { afterOnce.Do(func() { saveAndPublish("during replay (after query)") }) }
+
+	stream := join(t, ctx, client, "alice", "room")
+	events := recvUntil(t, stream, func(ev *chatpb.ChatEvent) bool { return ev.GetMessage().GetMessageId() == msgID(3) })
+
+	// 실시간으로 중복된 두 번째 메시지가 남아 있으면 다음 메시지 전에 나옴
+	saveAndPublish("after join")
+	events = append(events, recvUntil(t, stream, func(ev *chatpb.ChatEvent) bool { return ev.GetMessage().GetMessageId() == msgID(4) })...)
+
+	if got, want := messageIDs(events), []string{msgID(1), msgID(2), msgID(3), msgID(4)}; !slices.Equal(got, want) {
+		t.Fatalf("received messages %v, want %v", got, want)
+	}
+}
+
+func TestJoinChatReturnsReplayError(t *testing.T) {
+	repo := newMemRepo("alice")
+	repo.historyErr = errors.New("injected: connection reset")
+	_, client := startChatServer(t, config.Default().ChatService, repo, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream := join(t, ctx, client, "alice", "room")
+	if _, err := stream.Recv(); status.Code(err) != codes.Internal {
+		t.Fatalf("Recv err = %v, want Internal replay error", err)
+	}
+}
+
+// 메시지를 보내고 바로 송신을 끝내도(half-close) 쌓여 있던 ack를 모두 받은 뒤 스트림이 정상 종료되어야 함
+func TestJoinChatFlushesAcksAfterHalfClose(t *testing.T) {
+	repo := newMemRepo("alice")
+	_, client := startChatServer(t, config.Default().ChatService, repo, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream := join(t, ctx, client, "alice", "room")
+	const n = 20
+	for i := range n {
+		if err := stream.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: &chatpb.ChatMessage{
+			Message: "bye", ClientMessageId: fmt.Sprintf("c%d", i),
+		}}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := recvErr(stream)
+	if err != io.EOF {
+		t.Fatalf("stream ended with %v, want a clean EOF", err)
+	}
+	acks := 0
+	for _, ev := range events {
+		if ev.GetAck() != nil {
+			acks++
+		}
+	}
+	if acks != n {
+		t.Fatalf("got %d acks before the stream ended, want %d", acks, n)
+	}
+}
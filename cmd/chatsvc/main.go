@@ -2,75 +2,89 @@ package main
 
 import (
 	"context"
+	"errors"
+	"expvar"
 	"fmt"
 	"io"
 	"log"
 	"net"
+	"net/http"
 	"sync"
+	"time"
 
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/broker"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
 	"github.com/Dorazi23/gRPC_Chat_Project/internal/db"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/server"
 	"github.com/Dorazi23/gRPC_Chat_Project/internal/user"
 	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 현재 ChatMessage envelope 버전
+const messageEnvelopeVersion = 1
+
+const (
+	defaultRoomPageSize = 20  // GetMyRooms 기본 페이지 크기
+	maxRoomPageSize     = 100 // GetMyRooms limit 상한
+
+	maxMessageBytes = 4000 // 메시지 본문 최대 크기
+
+	saveTimeout = 10 * time.Second // 메시지 하나 저장 제한 시간
 )
 
 // ChatServer: 채팅 서버 구조체
 type ChatServer struct {
 	chatpb.UnimplementedChatServiceServer
-	clients  map[string][]chatpb.ChatService_JoinChatServer
+	clients  map[string][]*subscriber // 이 인스턴스에 접속한 스트림들 (방 ID별)
+	roomSubs map[string]func()        // 방별 브로커 구독 해제 함수 (로컬 접속자가 있는 방만)
 	mu       sync.RWMutex
+	cfg      config.ChatServiceConfig
 	chatRepo user.ChatRepository
+	broker   broker.Broker
+
+	draining bool           // Shutdown 이후에는 새 접속/메시지를 받지 않음 (mu로 보호)
+	inflight sync.WaitGroup // 진행 중인 saveAndBroadcast (Shutdown이 끝나기를 기다림)
 }
 
 // NewChatServer: 생성자
-func NewChatServer(repo user.ChatRepository) *ChatServer {
+func NewChatServer(cfg config.ChatServiceConfig, repo user.ChatRepository, b broker.Broker) *ChatServer {
 	return &ChatServer{
-		clients:  make(map[string][]chatpb.ChatService_JoinChatServer),
+		cfg:      cfg,
+		clients:  make(map[string][]*subscriber),
+		roomSubs: make(map[string]func()),
 		chatRepo: repo,
+		broker:   b,
 	}
 }
 
-// GetRoomID: UUID 앞 3글자를 따서 방 ID 생성 + 방 DB 생성까지 처리
+// GetRoomID: 두 유저의 1:1 방 ID 조회 (없으면 서버가 UUID로 새로 발급)
+// 같은 두 사람(순서 무관)에게는 항상 같은 방을 돌려줌
 func (s *ChatServer) GetRoomID(ctx context.Context, req *chatpb.GetRoomIDRequest) (*chatpb.GetRoomIDResponse, error) {
-	myID := req.MyId
+	// 내 아이디는 토큰 기준 (요청 값은 토큰과 일치할 때만 허용)
+	myID, err := callerUsername(ctx, req.MyId)
+	if err != nil {
+		return nil, err
+	}
 	otherID := req.OtherId
 
-	if myID == "" || otherID == "" {
+	if otherID == "" {
 		return nil, status.Error(codes.InvalidArgument, "ID cannot be empty")
 	}
 
-	// 1. 두 유저의 UUID와 가입일(CreatedAt) 조회
-	uuid1, created1, err := s.chatRepo.GetUserInfo(ctx, myID)
-	if err != nil {
+	// 1. 두 유저가 실제로 존재하는지 확인
+	if _, _, err := s.chatRepo.GetUserInfo(ctx, myID); err != nil {
 		return nil, status.Errorf(codes.NotFound, "failed to find user %s: %v", myID, err)
 	}
-	uuid2, created2, err := s.chatRepo.GetUserInfo(ctx, otherID)
-	if err != nil {
+	if _, _, err := s.chatRepo.GetUserInfo(ctx, otherID); err != nil {
 		return nil, status.Errorf(codes.NotFound, "failed to find user %s: %v", otherID, err)
 	}
 
-	// 2. 가입 순서(테이블 저장 순서)대로 정렬
-	// created1이 더 빠르면(옛날이면) user1이 앞
-	var firstUUID, secondUUID string
-	// 만약 가입 시간이 완전히 똑같으면(거의 없겠지만) UUID 문자열로 2차 정렬
-	if created1.Before(created2) || (created1.Equal(created2) && uuid1 < uuid2) {
-		firstUUID = uuid1
-		secondUUID = uuid2
-	} else {
-		firstUUID = uuid2
-		secondUUID = uuid1
-	}
-
-	// 3. UUID 앞 3글자씩 잘라서 합치기 (총 6글자)
-	if len(firstUUID) < 3 || len(secondUUID) < 3 {
-		return nil, status.Error(codes.Internal, "UUID is too short")
-	}
-	roomID := firstUUID[:3] + secondUUID[:3]
-
-	// 4. 여기서 DB에 방을 미리 만들어 둡니다.
-	err = s.chatRepo.EnsureRoomExists(ctx, roomID, myID, otherID)
+	// 2. 두 사람의 방을 조회하거나 새로 만듦 (방 ID는 DB에서 발급)
+	roomID, err := s.chatRepo.EnsureDirectRoom(ctx, myID, otherID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create room: %v", err)
 	}
@@ -82,179 +96,494 @@ func (s *ChatServer) GetRoomID(ctx context.Context, req *chatpb.GetRoomIDRequest
 
 // [추가] GetMyRooms: 내 채팅방 목록 조회
 func (s *ChatServer) GetMyRooms(ctx context.Context, req *chatpb.GetMyRoomsRequest) (*chatpb.GetMyRoomsResponse, error) {
-	myID := req.UserId
-	if myID == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	myID, err := callerUsername(ctx, req.UserId)
+	if err != nil {
+		return nil, err
 	}
 
-	// 1. DB에서 내가 속한 방 목록 가져오기
-	rawRooms, err := s.chatRepo.GetRoomsByUser(ctx, myID)
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultRoomPageSize
+	}
+	if limit > maxRoomPageSize {
+		limit = maxRoomPageSize
+	}
+
+	var after *user.RoomCursor
+	if req.PageToken != "" {
+		after, err = user.DecodeRoomCursor(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+	}
+
+	// 1. DB에서 내가 속한 방 목록 가져오기 (한 개 더 가져와서 다음 페이지가 있는지 판단)
+	rawRooms, err := s.chatRepo.GetRoomsByUser(ctx, myID, after, limit+1)
 	if err != nil {
 		log.Printf("DB Error: 방 목록 조회 실패: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to get rooms")
 	}
 
+	var nextPageToken string
+	if len(rawRooms) > limit {
+		rawRooms = rawRooms[:limit]
+		nextPageToken = user.RoomCursorOf(rawRooms[limit-1]).Encode()
+	}
+
 	// 2. 응답 데이터 만들기
+	// (GetRoomsByUser는 room_members 기준이라 내가 멤버인 방만 돌려줌)
 	var responseRooms []*chatpb.ChatRoomInfo
 	for _, r := range rawRooms {
-		// 상대방 아이디 찾기 (둘 중 내가 아닌 사람이 상대방)
-		var otherID string
-		if r.User1ID == myID {
-			otherID = r.User2ID
-		} else {
-			otherID = r.User1ID
+		info := &chatpb.ChatRoomInfo{
+			RoomId:            r.RoomID,
+			IsGroup:           r.RoomType == user.RoomTypeGroup,
+			Title:             r.Title,
+			MemberCount:       int32(r.MemberCount),
+			UnreadCount:       int32(r.UnreadCount),
+			LastReadMessageId: r.LastReadMessageID,
+			LastActivityAt:    timestamppb.New(r.LastActivityAt),
 		}
 
-		responseRooms = append(responseRooms, &chatpb.ChatRoomInfo{
-			RoomId:      r.RoomID,
-			OtherUserId: otherID,
-		})
+		// 1:1 방이면 상대방 아이디 찾기 (둘 중 내가 아닌 사람이 상대방)
+		if !info.IsGroup {
+			if r.User1ID == myID {
+				info.OtherUserId = r.User2ID
+			} else {
+				info.OtherUserId = r.User1ID
+			}
+			info.OtherUserNickname = r.OtherNickname
+			info.OtherUserAvatarUrl = r.OtherAvatarURL
+		}
+
+		// 마지막 메시지 미리보기
+		if r.LastMessageID != "" {
+			info.LastMessageId = r.LastMessageID
+			info.LastMessagePreview = r.LastMessagePreview
+			info.LastMessageSenderId = r.LastMessageSenderID
+			info.LastMessageUsername = r.LastMessageUsername
+			info.LastMessageAt = timestamppb.New(r.LastMessageAt)
+		}
+
+		responseRooms = append(responseRooms, info)
 	}
 
 	return &chatpb.GetMyRoomsResponse{
-		Rooms: responseRooms,
+		Rooms:         responseRooms,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
-// JoinChat: 채팅방 참여 및 메시지 송수신
+// JoinChat: 채팅방 참여 및 이벤트 송수신
 func (s *ChatServer) JoinChat(stream chatpb.ChatService_JoinChatServer) error {
-	// 1. 초기 메시지 수신
-	initialMsg, err := stream.Recv()
+	// 1. 초기 메시지(입장 핸드셰이크) 수신
+	firstEvent, err := stream.Recv()
 	if err != nil {
 		log.Printf("초기 메시지 수신 실패: %v", err)
 		return status.Errorf(codes.InvalidArgument, "초기 메시지 수신 실패: %v", err)
 	}
+	initialMsg := firstEvent.GetMessage()
+	if initialMsg == nil {
+		return status.Error(codes.InvalidArgument, "첫 이벤트는 입장 메시지여야 함")
+	}
 
 	if initialMsg.Roomid == "" {
 		return status.Error(codes.InvalidArgument, "방 ID가 비어 있음")
 	}
-	if initialMsg.Username == "" {
-		return status.Error(codes.InvalidArgument, "유저명이 비어 있음")
+
+	// 1.2 유저명은 토큰 기준 (클라이언트가 보낸 Username은 토큰과 같을 때만 허용)
+	userName, err := callerUsername(stream.Context(), initialMsg.Username)
+	if err != nil {
+		return err
 	}
 
 	// 1.5 DB에 실제 유저가 존재하는지 확인
-	exists, err := s.chatRepo.UserExists(stream.Context(), initialMsg.Username)
+	exists, err := s.chatRepo.UserExists(stream.Context(), userName)
 	if err != nil {
 		log.Printf("DB Error: 유저 확인 실패: %v", err)
 	}
 	if !exists {
-		log.Printf("경고: 존재하지 않는 유저(%s)가 접속을 시도했습니다.", initialMsg.Username)
-		return status.Errorf(codes.Unauthenticated, "User '%s' does not exist in database", initialMsg.Username)
+		log.Printf("경고: 존재하지 않는 유저(%s)가 접속을 시도했습니다.", userName)
+		return status.Errorf(codes.Unauthenticated, "User '%s' does not exist in database", userName)
 	}
 
 	roomID := initialMsg.Roomid
-	userName := initialMsg.Username
-	senderID := fmt.Sprintf("TEMP_USER_%s", userName)
 
-	// 3. 과거 메시지 로드 및 전송
-	log.Printf("방(%s)의 이전 대화 내용을 불러옵니다...", roomID)
-	history, err := s.chatRepo.GetMessagesByRoomID(stream.Context(), roomID, 50)
-	if err != nil {
-		log.Printf("DB Error: 기록 로드 실패: %v", err)
-	} else {
-		for _, record := range history {
-			historyMsg := &chatpb.ChatMessage{
-				Roomid:   record.RoomID,
-				Username: record.Username,
-				Message:  record.MessageContent,
-			}
-			if err := stream.Send(historyMsg); err != nil {
-				log.Printf("기록 전송 실패 (%s): %v", userName, err)
-				break
-			}
-		}
+	// 1.7 방 멤버인지 확인 (멤버가 아니면 기록도 보여주지 않음)
+	if err := s.requireRoomMember(stream.Context(), roomID, userName); err != nil {
+		log.Printf("경고: '%s' 님이 멤버가 아닌 방(%s)에 접근을 시도했습니다.", userName, roomID)
+		return err
 	}
 
-	// 4. 클라이언트 메모리에 등록
-	s.mu.Lock()
-	s.clients[roomID] = append(s.clients[roomID], stream)
-	s.mu.Unlock()
+	// 보낸 사람 ID는 토큰의 유저 ID
+	senderID, ok := user.UserIDFromContext(stream.Context())
+	if !ok || senderID == "" {
+		return status.Error(codes.Unauthenticated, "no user in context")
+	}
 
-	log.Printf("'%s' 님이 '%s' 방에 참가했습니다.", userName, roomID)
+	// 3. 클라이언트 메모리에 등록한 뒤 과거 메시지 전송
+	// 기록을 조회하는 동안 저장된 메시지를 놓치지 않도록 먼저 구독하고 (그동안 받은 실시간 이벤트는 송신 큐에 쌓임),
+	// 재접속(resume_cursor / last_message_id)이면 그 이후 메시지만, 아니면 최근 메시지 한 페이지를 보냄
+	resumeFrom, err := s.resumePoint(stream.Context(), roomID, initialMsg)
+	if err != nil {
+		return err
+	}
+	sub := newSubscriber(stream, senderID, userName, s.cfg.SendQueueSize)
+	firstConn, online, err := s.addSubscriber(roomID, sub)
+	if err != nil {
+		return err
+	}
 
-	// 5. 연결 종료 시 정리 (Defer)
+	// 4. 연결 종료 시 정리 (Defer)
+	// 입장 알림을 보내지 못하고 끝난 첫 연결이면 퇴장 알림도 보내지 않음
+	// writeLoop를 시작했으면 끝날 때까지 기다린 뒤 반환 (핸들러가 반환된 뒤에는 Send하면 안 됨)
+	joined, writing := false, false
 	defer func() {
-		s.mu.Lock()
-		roomClients := s.clients[roomID]
-		var updatedClients []chatpb.ChatService_JoinChatServer
-		for _, client := range roomClients {
-			if client != stream {
-				updatedClients = append(updatedClients, client)
-			}
+		sub.close(nil)
+		if writing {
+			sub.waitWritten()
 		}
-
-		if len(updatedClients) == 0 {
-			delete(s.clients, roomID)
-			log.Printf("방('%s')이 비어서 삭제되었습니다.", roomID)
-		} else {
-			s.clients[roomID] = updatedClients
+		if lastConn := s.removeSubscriber(roomID, sub); lastConn && (joined || !firstConn) {
+			s.broadcastMessage(roomID, &chatpb.ChatEvent{
+				RoomId: roomID,
+				Event:  &chatpb.ChatEvent_MemberLeft{MemberLeft: &chatpb.MemberEvent{UserId: senderID, Username: userName}},
+			}, nil)
 		}
-		s.mu.Unlock()
 		log.Printf("'%s' 님이 퇴장했습니다.", userName)
 	}()
 
-	// 6. 입장 메시지 저장 및 브로드캐스트
-	if err := s.chatRepo.SaveMessage(stream.Context(), roomID, senderID, userName, initialMsg.Message); err != nil {
-		log.Printf("DB 저장 실패(입장): %v", err)
+	log.Printf("방(%s)의 이전 대화 내용을 불러옵니다...", roomID)
+	replayed, err := s.replayHistory(stream, roomID, resumeFrom)
+	if err != nil {
+		log.Printf("기록 전송 실패 (%s): %v", userName, err)
+		return err
+	}
+
+	// 5. 송신 시작
+	// 여기서부터 스트림 전송은 sub의 송신 큐를 거침 (writeLoop 하나만 Send 호출)
+	// 기록으로 이미 보낸 메시지가 큐에 실시간 이벤트로도 들어와 있으면 writeLoop가 건너뜀
+	// 입장 알림은 저장하지 않는 일회성 이벤트이고, 같은 유저의 첫 연결일 때만 보냄
+	sub.replayed = replayed
+	writing = true
+	go sub.writeLoop()
+	go s.watchAccess(sub, roomID)
+	sub.enqueue(&chatpb.ChatEvent{
+		RoomId: roomID,
+		Event:  &chatpb.ChatEvent_Presence{Presence: &chatpb.PresenceEvent{OnlineUsernames: online}},
+	})
+	if firstConn {
+		s.broadcastMessage(roomID, &chatpb.ChatEvent{
+			RoomId: roomID,
+			Event:  &chatpb.ChatEvent_MemberJoined{MemberJoined: &chatpb.MemberEvent{UserId: senderID, Username: userName}},
+		}, sub)
+		joined = true
+	}
+
+	log.Printf("'%s' 님이 '%s' 방에 참가했습니다.", userName, roomID)
+
+	// 6. 이벤트 수신 루프
+	// 수신 루프는 별도 goroutine에서 돌리고, 송신 큐 초과/전송 실패로 sub가 닫히면 바로 스트림을 끝냄
+	recvErr := make(chan error, 1)
+	go func() {
+		recvErr <- s.receiveLoop(sub, roomID)
+	}()
+
+	// 클라이언트가 송신을 끝내면(half-close) 그때까지 쌓인 ack 등을 모두 보낸 뒤 끝냄
+	select {
+	case err := <-recvErr:
+		if err != nil {
+			return err
+		}
+		sub.finish()
+		sub.waitWritten()
+		return sub.err
+	case <-sub.done:
+		return sub.err
 	}
-	s.broadcastMessage(roomID, initialMsg)
+}
 
-	// 7. 메시지 수신 루프
+// receiveLoop: 클라이언트가 보낸 이벤트 처리 (스트림이 끝나면 io.EOF는 nil로 돌려줌)
+func (s *ChatServer) receiveLoop(sub *subscriber, roomID string) error {
 	for {
-		msg, err := stream.Recv()
+		ev, err := sub.stream.Recv()
 		if err == io.EOF {
 			return nil
 		}
 		if err != nil {
-			log.Printf("연결 오류 (%s): %v", userName, err)
+			log.Printf("연결 오류 (%s): %v", sub.username, err)
 			return err
 		}
 
-		if msg.Message == "" {
-			continue
-		}
+		switch e := ev.Event.(type) {
+		case *chatpb.ChatClientEvent_Message:
+			msg := e.Message
 
-		log.Printf("[%s] %s: %s", msg.Roomid, msg.Username, msg.Message)
+			// 입장한 방/토큰의 유저와 다른 값으로 보내는 메시지는 거부
+			if (msg.Roomid != "" && msg.Roomid != roomID) || (msg.Username != "" && msg.Username != sub.username) {
+				return status.Error(codes.PermissionDenied, "room or username does not match the joined session")
+			}
+			msg.Roomid = roomID
+			msg.Username = sub.username
+
+			if msg.Message == "" {
+				continue
+			}
+			// 브로커(Postgres NOTIFY 8000바이트 제한)로 전달할 수 있는 크기까지만 허용
+			if len(msg.Message) > maxMessageBytes {
+				sendNack(sub, roomID, msg.ClientMessageId, codes.InvalidArgument, "message too long", false)
+				continue
+			}
+
+			log.Printf("[%s] %s: %s", msg.Roomid, msg.Username, msg.Message)
+
+			s.saveAndBroadcast(sub, roomID, msg)
+
+		case *chatpb.ChatClientEvent_Typing:
+			// 타이핑 표시는 저장하지 않고 다른 접속자에게만 전달
+			s.broadcastMessage(roomID, &chatpb.ChatEvent{
+				RoomId: roomID,
+				Event: &chatpb.ChatEvent_Typing{Typing: &chatpb.TypingEvent{
+					IsTyping: e.Typing.IsTyping,
+					UserId:   sub.userID,
+					Username: sub.username,
+				}},
+			}, sub)
+
+		default:
+			sendStreamError(sub, roomID, codes.InvalidArgument, "unknown event", "")
+		}
+	}
+}
 
-		if err := s.chatRepo.SaveMessage(stream.Context(), msg.Roomid, senderID, msg.Username, msg.Message); err != nil {
-			log.Printf("DB 저장 실패(대화): %v", err)
+// resumePoint: 재접속한 클라이언트가 보낸 위치(resume_cursor 또는 last_message_id)를 cursor로 변환
+// 둘 다 없으면 nil (처음 입장)
+func (s *ChatServer) resumePoint(ctx context.Context, roomID string, initialMsg *chatpb.ChatMessage) (*user.MessageCursor, error) {
+	if initialMsg.ResumeCursor != "" {
+		cursor, err := user.DecodeMessageCursor(initialMsg.ResumeCursor)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid resume_cursor")
 		}
+		return cursor, nil
+	}
 
-		s.broadcastMessage(msg.Roomid, msg)
+	if initialMsg.LastMessageId != "" {
+		record, err := s.chatRepo.GetMessageByID(ctx, roomID, initialMsg.LastMessageId)
+		if err != nil {
+			if errors.Is(err, user.ErrMessageNotFound) {
+				return nil, status.Error(codes.NotFound, "last_message_id not found in the room")
+			}
+			log.Printf("DB Error: 마지막 메시지 조회 실패: %v", err)
+			return nil, status.Error(codes.Internal, "failed to resolve last_message_id")
+		}
+		return user.CursorOf(record), nil
 	}
+
+	return nil, nil
 }
 
-func (s *ChatServer) broadcastMessage(roomID string, msg *chatpb.ChatMessage) {
-	s.mu.RLock()
-	clients := s.clients[roomID]
-	s.mu.RUnlock()
+// saveAndBroadcast: 메시지를 저장하고, commit이 끝난 뒤에만 보낸 사람에게 ack를, 방에 메시지를 브로드캐스트함
+// 저장에 실패하면 보낸 사람에게 nack만 보내고 브로드캐스트하지 않음 (기록에 없는 메시지가 퍼지지 않도록)
+// 그사이 세션이 끊겼으면 Unauthenticated로, 방에서 내보내졌으면 PermissionDenied로 저장하지 않고 연결을 끊음
+// 같은 client_message_id로 재전송된 메시지는 다시 저장/브로드캐스트하지 않고 duplicate ack만 보냄
+func (s *ChatServer) saveAndBroadcast(sub *subscriber, roomID string, msg *chatpb.ChatMessage) {
+	if !s.beginInflight() {
+		sendNack(sub, roomID, msg.ClientMessageId, codes.Unavailable, "server is shutting down", true)
+		return
+	}
+	defer s.inflight.Done()
+
+	// 클라이언트가 저장 도중 끊어도 저장은 끝까지 진행 (commit 여부가 애매해지지 않도록)
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(sub.stream.Context()), saveTimeout)
+	defer cancel()
 
-	for _, client := range clients {
-		if err := client.Send(msg); err != nil {
-			log.Printf("브로드캐스트 전송 실패: %v", err)
+	if err := user.CheckSession(ctx); err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			log.Printf("세션이 끊긴 '%s' 님의 메시지를 거부하고 연결을 끊습니다.", sub.username)
+			sub.close(err)
+			return
 		}
+		log.Printf("세션 확인 실패 (%s, client_message_id=%s): %v", sub.username, msg.ClientMessageId, err)
+		sendNack(sub, roomID, msg.ClientMessageId, codes.Unavailable, "failed to check session", true)
+		return
+	}
+
+	record, duplicate, err := s.chatRepo.SaveMessage(ctx, roomID, sub.userID, sub.username, msg.Message, msg.ClientMessageId)
+	if errors.Is(err, user.ErrNotRoomMember) {
+		// 입장한 뒤에 방에서 내보내진 멤버 (다른 인스턴스/관리자가 내보낸 경우 포함)
+		log.Printf("방(%s)에서 내보내진 '%s' 님의 메시지를 거부하고 연결을 끊습니다.", roomID, sub.username)
+		sub.close(errRemovedFromRoom)
+		return
+	}
+	if err != nil {
+		log.Printf("DB 저장 실패 (%s, client_message_id=%s): %v", sub.username, msg.ClientMessageId, err)
+		sendNack(sub, roomID, msg.ClientMessageId, codes.Unavailable, "failed to store message", true)
+		return
 	}
+
+	sub.enqueue(&chatpb.ChatEvent{
+		RoomId: roomID,
+		Event: &chatpb.ChatEvent_Ack{Ack: &chatpb.MessageAckEvent{
+			ClientMessageId: msg.ClientMessageId,
+			Message:         toProtoMessage(record),
+			Duplicate:       duplicate,
+		}},
+	})
+
+	if duplicate {
+		log.Printf("중복 메시지 무시 (%s, client_message_id=%s)", sub.username, msg.ClientMessageId)
+		return
+	}
+	s.broadcastMessage(roomID, messageEvent(record), nil)
+}
+
+// sendNack: 보낸 메시지가 저장되지 않았음을 보낸 사람에게 알림
+func sendNack(sub *subscriber, roomID, clientMsgID string, code codes.Code, msg string, retryable bool) {
+	sub.enqueue(&chatpb.ChatEvent{
+		RoomId: roomID,
+		Event: &chatpb.ChatEvent_Nack{Nack: &chatpb.MessageNackEvent{
+			ClientMessageId: clientMsgID,
+			Code:            int32(code),
+			Message:         msg,
+			Retryable:       retryable,
+		}},
+	})
+}
+
+// messageEvent: 저장된 메시지 → ChatEvent
+func messageEvent(r *user.MessageRecord) *chatpb.ChatEvent {
+	return &chatpb.ChatEvent{
+		RoomId: r.RoomID,
+		Event:  &chatpb.ChatEvent_Message{Message: toProtoMessage(r)},
+	}
+}
+
+// sendStreamError: 스트림을 끊지 않고 에러 이벤트만 보냄
+func sendStreamError(sub *subscriber, roomID string, code codes.Code, msg, clientMsgID string) {
+	sub.enqueue(&chatpb.ChatEvent{
+		RoomId: roomID,
+		Event: &chatpb.ChatEvent_Error{Error: &chatpb.ErrorEvent{
+			Code:            int32(code),
+			Message:         msg,
+			ClientMessageId: clientMsgID,
+		}},
+	})
+}
+
+// toProtoMessage: DB 메시지 레코드 → proto ChatMessage (envelope 버전 포함)
+func toProtoMessage(r *user.MessageRecord) *chatpb.ChatMessage {
+	return &chatpb.ChatMessage{
+		Roomid:          r.RoomID,
+		Username:        r.Username,
+		Message:         r.MessageContent,
+		Version:         messageEnvelopeVersion,
+		MessageId:       r.ID,
+		SentAt:          timestamppb.New(r.SentAt),
+		SenderId:        r.SenderID,
+		Cursor:          messageCursor(r),
+		ClientMessageId: r.ClientMsgID,
+	}
+}
+
+// messageCursor: 저장된 메시지만 cursor를 가짐 (저장 실패한 메시지는 빈 값)
+func messageCursor(r *user.MessageRecord) string {
+	if r.ID == "" {
+		return ""
+	}
+	return user.CursorOf(r).Encode()
+}
+
+// requireRoomMember: 방 멤버가 아니면 PermissionDenied
+func (s *ChatServer) requireRoomMember(ctx context.Context, roomID, userName string) error {
+	ok, err := s.chatRepo.IsRoomMember(ctx, roomID, userName)
+	if err != nil {
+		log.Printf("DB Error: 방 멤버 확인 실패: %v", err)
+		return status.Error(codes.Internal, "failed to check room membership")
+	}
+	if !ok {
+		return status.Error(codes.PermissionDenied, "not a member of the room")
+	}
+	return nil
+}
+
+// callerUsername: 토큰의 username을 돌려줌. 요청에 다른 username이 들어있으면 PermissionDenied
+func callerUsername(ctx context.Context, requested string) (string, error) {
+	username, ok := user.UsernameFromContext(ctx)
+	if !ok || username == "" {
+		return "", status.Error(codes.Unauthenticated, "no user in context")
+	}
+	if requested != "" && requested != username {
+		return "", status.Error(codes.PermissionDenied, "user does not match the access token")
+	}
+	return username, nil
 }
 
 func main() {
-	db.Init()
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run: 서버를 띄우고 종료될 때까지 기다림
+// 에러는 돌려주기만 하고 main에서 한 번만 종료해서, 등록된 defer(브로커/DB 풀 닫기)가 항상 실행되게 함
+func run() error {
+	// 설정 로드 (--print-config면 출력 후 종료)
+	cfg := config.MustLoad()
+	if err := user.ConfigureAuth(cfg.Auth); err != nil {
+		return fmt.Errorf("failed to load JWT keys: %w", err)
+	}
+
+	db.Init(cfg.Database)
 	defer db.Pool.Close()
+	user.ConfigureSessions(user.NewSessionChecker(db.Pool))
 
 	chatRepo := user.NewChatRepository(db.Pool)
 
-	lis, err := net.Listen("tcp", ":50052")
+	// 브로커 선택 (chatsvc.broker=postgres면 여러 인스턴스끼리 LISTEN/NOTIFY로 이벤트 공유)
+	var chatBroker broker.Broker
+	switch cfg.ChatService.Broker {
+	case "postgres":
+		chatBroker = broker.NewPostgres(db.Pool)
+		log.Println("Broker: postgres LISTEN/NOTIFY")
+	default:
+		chatBroker = broker.NewMemory()
+		log.Println("Broker: in-memory (single node)")
+	}
+	defer chatBroker.Close()
+
+	lis, err := net.Listen("tcp", cfg.ChatService.ListenAddr)
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+		return fmt.Errorf("failed to listen: %w", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	chatServer := NewChatServer(chatRepo)
+	// UserService와 같은 JWT로 unary / stream 모두 인증 (tls.enabled면 TLS)
+	creds, err := server.ServerCredentials(cfg.TLS)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS config: %w", err)
+	}
+	grpcServer := grpc.NewServer(
+		creds,
+		grpc.UnaryInterceptor(user.UnaryAuthInterceptor),
+		grpc.StreamInterceptor(user.StreamAuthInterceptor),
+	)
+	chatServer := NewChatServer(cfg.ChatService, chatRepo, chatBroker)
+
+	// 송신 큐 깊이 등 expvar 지표 (chatsvc.metrics_addr가 있으면 /debug/vars로 노출)
+	expvar.Publish("chatsvc_send_queues", expvar.Func(chatServer.queueStats))
+	if addr := cfg.ChatService.MetricsAddr; addr != "" {
+		go func() {
+			log.Printf("Metrics: http://%s/debug/vars", addr)
+			if err := http.ListenAndServe(addr, nil); err != nil {
+				log.Printf("metrics server stopped: %v", err)
+			}
+		}()
+	}
 
 	chatpb.RegisterChatServiceServer(grpcServer, chatServer)
 
-	log.Println("✅ Chat gRPC Server started on :50052")
+	log.Printf("✅ Chat gRPC Server started on %s", cfg.ChatService.ListenAddr)
 
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+	// SIGINT/SIGTERM: 진행 중인 저장 완료 대기 → 접속자에게 종료 알림(그 전의 ack까지 전송) → GracefulStop → (defer) 브로커/DB 풀 닫기
+	if err := server.ServeUntilSignal(grpcServer, lis, cfg.ChatService.ShutdownTimeout, chatServer.Shutdown); err != nil {
+		return fmt.Errorf("failed to serve: %w", err)
 	}
+	return nil
 }
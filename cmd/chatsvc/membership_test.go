@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 방 멤버가 아니면 방 ID를 알아도 입장/기록 조회/읽음 처리/멤버 조회가 모두 거부되어야 함
+func TestRoomAccessRequiresMembership(t *testing.T) {
+	repo := newMemRepo("alice", "bob", "mallory")
+	repo.setMembers("room", "alice", "bob")
+	repo.setMembers("no-such-room")
+	_, client := startChatServer(t, config.Default().ChatService, repo, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	calls := map[string]func(ctx context.Context, roomID string) error{
+		"JoinChat": func(ctx context.Context, roomID string) error {
+			return joinErr(ctx, client, &chatpb.ChatMessage{Roomid: roomID})
+		},
+		"GetMessages": func(ctx context.Context, roomID string) error {
+			_, err := client.GetMessages(ctx, &chatpb.GetMessagesRequest{RoomId: roomID})
+			return err
+		},
+		"MarkRoomRead": func(ctx context.Context, roomID string) error {
+			_, err := client.MarkRoomRead(ctx, &chatpb.MarkRoomReadRequest{RoomId: roomID, MessageId: msgID(1)})
+			return err
+		},
+		"ListRoomMembers": func(ctx context.Context, roomID string) error {
+			_, err := client.ListRoomMembers(ctx, &chatpb.ListRoomMembersRequest{RoomId: roomID})
+			return err
+		},
+	}
+	for name, call := range calls {
+		for _, tc := range []struct {
+			username, roomID string
+		}{
+			{"mallory", "room"},
+			{"alice", "no-such-room"}, // 없는 방도 멤버가 아닌 방과 구분하지 않음
+		} {
+			if err := call(asUser(t, ctx, tc.username), tc.roomID); status.Code(err) != codes.PermissionDenied {
+				t.Errorf("%s by %s in %s: err = %v, want PermissionDenied", name, tc.username, tc.roomID, err)
+			}
+		}
+	}
+
+	// 멤버는 그대로 입장하고 기록을 볼 수 있음
+	if err := calls["JoinChat"](asUser(t, ctx, "alice"), "room"); err != nil {
+		t.Errorf("JoinChat by member: %v", err)
+	}
+	if err := calls["GetMessages"](asUser(t, ctx, "bob"), "room"); err != nil {
+		t.Errorf("GetMessages by member: %v", err)
+	}
+}
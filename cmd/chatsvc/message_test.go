@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 메시지 ID/시각/보낸 사람은 클라이언트가 보낸 값과 상관없이 서버가 채워서 ack, 실시간 전달, 기록 모두에 실려야 함
+func TestMessageEnvelopeIsFilledByServer(t *testing.T) {
+	repo := newMemRepo("alice", "bob", "carol")
+	_, client := startChatServer(t, config.Default().ChatService, repo, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	alice := join(t, ctx, client, "alice", "room")
+	recvUntil(t, alice, func(ev *chatpb.ChatEvent) bool { return ev.GetPresence() != nil })
+	bob := join(t, ctx, client, "bob", "room")
+	recvUntil(t, bob, func(ev *chatpb.ChatEvent) bool { return ev.GetPresence() != nil })
+
+	if err := alice.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: &chatpb.ChatMessage{
+		Message:         "hi",
+		ClientMessageId: "c1",
+		Version:         99,
+		MessageId:       "forged-id",
+		SenderId:        "id-bob",
+		SentAt:          timestamppb.New(time.Unix(0, 0)),
+	}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	acked := recvUntil(t, alice, func(ev *chatpb.ChatEvent) bool { return ev.GetAck() != nil })
+	live := recvUntil(t, bob, func(ev *chatpb.ChatEvent) bool { return ev.GetMessage() != nil })
+	replayed := recvUntil(t, join(t, ctx, client, "carol", "room"), func(ev *chatpb.ChatEvent) bool { return ev.GetMessage() != nil })
+
+	repo.mu.Lock()
+	stored := repo.messages[0]
+	repo.mu.Unlock()
+	tests := []struct {
+		name string
+		msg  *chatpb.ChatMessage
+	}{
+		{"ack", acked[len(acked)-1].GetAck().GetMessage()},
+		{"live broadcast", live[len(live)-1].GetMessage()},
+		{"history replay", replayed[len(replayed)-1].GetMessage()},
+	}
+	for _, tt := range tests {
+		m := tt.msg
+		if m.GetVersion() != messageEnvelopeVersion || m.GetMessageId() != stored.ID || m.GetSenderId() != "id-alice" ||
+			m.GetUsername() != "alice" || !m.GetSentAt().AsTime().Equal(stored.SentAt) || m.GetClientMessageId() != "c1" || m.GetCursor() == "" {
+			t.Errorf("%s = %v, want the server-filled envelope of %+v", tt.name, m, stored)
+		}
+	}
+}
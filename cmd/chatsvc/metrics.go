@@ -0,0 +1,42 @@
+package main
+
+import (
+	"expvar"
+)
+
+// 송신 큐 초과로 끊은 연결 수
+var sendQueueEvictions = expvar.NewInt("chatsvc_send_queue_evictions")
+
+// sendQueueStats: 송신 큐 상태 (expvar "chatsvc_send_queues"로 노출)
+// /debug/vars는 인증 없이 열려 있으므로 방 ID 같은 식별자는 넣지 않고 합계만 노출함
+type sendQueueStats struct {
+	Subscribers   int `json:"subscribers"`
+	Rooms         int `json:"rooms"`           // 접속자가 있는 방 수
+	Capacity      int `json:"capacity"`        // 접속자별 큐 크기
+	Queued        int `json:"queued"`          // 전체 대기 중인 이벤트 수
+	MaxDepth      int `json:"max_depth"`       // 가장 많이 밀린 접속자의 대기 수
+	MaxRoomQueued int `json:"max_room_queued"` // 가장 많이 밀린 방의 대기 수 합계
+}
+
+// queueStats: 현재 이 인스턴스의 송신 큐 깊이를 모아서 돌려줌
+func (s *ChatServer) queueStats() any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := sendQueueStats{
+		Rooms:    len(s.clients),
+		Capacity: s.cfg.SendQueueSize,
+	}
+	for _, clients := range s.clients {
+		roomQueued := 0
+		for _, c := range clients {
+			depth := len(c.queue)
+			stats.Subscribers++
+			stats.Queued += depth
+			roomQueued += depth
+			stats.MaxDepth = max(stats.MaxDepth, depth)
+		}
+		stats.MaxRoomQueued = max(stats.MaxRoomQueued, roomQueued)
+	}
+	return stats
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 읽지 않는 접속자는 HTTP/2 윈도와 송신 큐가 차면 혼자 ResourceExhausted로 끊기고, 같은 방의 나머지는 계속 받아야 함
+func TestStalledReceiverIsEvictedAlone(t *testing.T) {
+	cfg := config.Default().ChatService
+	cfg.SendQueueSize = 8
+	s, client := startChatServer(t, cfg, newMemRepo("alice", "bob", "carol"), nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stalled := join(t, ctx, client, "alice", "room")
+	sender := join(t, ctx, client, "bob", "room")
+	reader := join(t, ctx, client, "carol", "room")
+	for s.queueStats().(sendQueueStats).Subscribers < 3 {
+		if ctx.Err() != nil {
+			t.Fatal("subscribers did not join")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	evictionsBefore := sendQueueEvictions.Value()
+
+	// 메시지 하나씩 bob의 ack와 carol의 수신을 확인하고 다음 메시지를 보냄 (alice는 전혀 읽지 않음)
+	const total = 60
+	body := strings.Repeat("x", maxMessageBytes)
+	for i := range total {
+		clientMsgID := fmt.Sprintf("c%d", i)
+		if err := sender.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: &chatpb.ChatMessage{
+			Message: body, ClientMessageId: clientMsgID,
+		}}}); err != nil {
+			t.Fatal(err)
+		}
+		recvUntil(t, sender, func(ev *chatpb.ChatEvent) bool { return ev.GetAck().GetClientMessageId() == clientMsgID })
+		recvUntil(t, reader, func(ev *chatpb.ChatEvent) bool { return ev.GetMessage().GetClientMessageId() == clientMsgID })
+	}
+
+	received := 0
+	for {
+		ev, err := stalled.Recv()
+		if err != nil {
+			if status.Code(err) != codes.ResourceExhausted {
+				t.Fatalf("stalled receiver err = %v, want ResourceExhausted", err)
+			}
+			break
+		}
+		if ev.GetMessage() != nil {
+			received++
+		}
+	}
+	if received >= total {
+		t.Fatalf("stalled receiver got all %d messages before being evicted", received)
+	}
+	if n := sendQueueEvictions.Value() - evictionsBefore; n != 1 {
+		t.Fatalf("%d evictions, want only the stalled receiver", n)
+	}
+}
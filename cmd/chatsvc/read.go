@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/user"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MarkRoomRead: message_id까지 읽음 처리
+// 읽음 위치가 실제로 앞으로 움직였을 때만 방 접속자에게 ReadReceiptEvent를 보냄
+func (s *ChatServer) MarkRoomRead(ctx context.Context, req *chatpb.MarkRoomReadRequest) (*chatpb.MarkRoomReadResponse, error) {
+	myID, err := callerUsername(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if req.RoomId == "" || req.MessageId == "" {
+		return nil, status.Error(codes.InvalidArgument, "room_id and message_id are required")
+	}
+	if err := s.requireRoomMember(ctx, req.RoomId, myID); err != nil {
+		return nil, err
+	}
+
+	record, err := s.chatRepo.GetMessageByID(ctx, req.RoomId, req.MessageId)
+	if err != nil {
+		if errors.Is(err, user.ErrMessageNotFound) {
+			return nil, status.Error(codes.NotFound, "message not found in the room")
+		}
+		log.Printf("DB Error: 메시지 조회 실패: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get message")
+	}
+
+	lastReadID, advanced, err := s.chatRepo.UpdateReadCursor(ctx, req.RoomId, myID, user.CursorOf(record))
+	if err != nil {
+		log.Printf("DB Error: 읽음 처리 실패: %v", err)
+		return nil, status.Error(codes.Internal, "failed to mark room read")
+	}
+
+	unread, err := s.chatRepo.CountUnread(ctx, req.RoomId, myID)
+	if err != nil {
+		log.Printf("DB Error: 안 읽은 수 조회 실패: %v", err)
+		return nil, status.Error(codes.Internal, "failed to count unread messages")
+	}
+
+	if advanced {
+		userID, _ := user.UserIDFromContext(ctx)
+		s.broadcastMessage(req.RoomId, &chatpb.ChatEvent{
+			RoomId: req.RoomId,
+			Event: &chatpb.ChatEvent_ReadReceipt{ReadReceipt: &chatpb.ReadReceiptEvent{
+				UserId:    userID,
+				Username:  myID,
+				MessageId: lastReadID,
+				ReadAt:    timestamppb.Now(),
+			}},
+		}, nil)
+	}
+
+	return &chatpb.MarkRoomReadResponse{
+		UnreadCount:       int32(unread),
+		LastReadMessageId: lastReadID,
+	}, nil
+}
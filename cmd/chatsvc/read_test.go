@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMarkRoomReadUpdatesUnreadCount(t *testing.T) {
+	repo := newMemRepo("alice", "bob")
+	repo.setMembers("room", "alice", "bob")
+	repo.setMembers("other", "alice", "bob")
+	for _, text := range []string{"one", "two", "three"} {
+		repo.SaveMessage(context.Background(), "room", "id-bob", "bob", text, "")
+	}
+	repo.SaveMessage(context.Background(), "other", "id-bob", "bob", "elsewhere", "")
+	_, client := startChatServer(t, config.Default().ChatService, repo, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	bob := join(t, ctx, client, "bob", "room")
+	recvUntil(t, bob, func(ev *chatpb.ChatEvent) bool { return ev.GetPresence() != nil })
+
+	// 순서대로 실행 (읽음 위치는 앞으로만 움직임)
+	tests := []struct {
+		name, messageID string
+		want            codes.Code
+		wantUnread      int32
+		wantLastRead    string
+	}{
+		{"read up to the second", msgID(2), codes.OK, 1, msgID(2)},
+		{"older message keeps the cursor", msgID(1), codes.OK, 1, msgID(2)},
+		{"message of another room", msgID(4), codes.NotFound, 0, ""},
+		{"unknown message", msgID(99), codes.NotFound, 0, ""},
+		{"read everything", msgID(3), codes.OK, 0, msgID(3)},
+	}
+	for _, tt := range tests {
+		resp, err := client.MarkRoomRead(asUser(t, ctx, "alice"), &chatpb.MarkRoomReadRequest{RoomId: "room", MessageId: tt.messageID})
+		if status.Code(err) != tt.want {
+			t.Fatalf("%s: err = %v, want %v", tt.name, err, tt.want)
+		}
+		if err == nil && (resp.UnreadCount != tt.wantUnread || resp.LastReadMessageId != tt.wantLastRead) {
+			t.Errorf("%s: unread = %d, last read = %s; want %d, %s", tt.name, resp.UnreadCount, resp.LastReadMessageId, tt.wantUnread, tt.wantLastRead)
+		}
+	}
+
+	// 읽음 위치가 움직인 두 번만 읽음 확인 이벤트가 감 (인메모리 브로커는 바로 전달하므로 뒤에 보낸 메시지의 ack보다 먼저 옴)
+	if err := bob.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: &chatpb.ChatMessage{
+		Message: "seen?", ClientMessageId: "bob-1",
+	}}}); err != nil {
+		t.Fatal(err)
+	}
+	var receipts []string
+	for _, ev := range recvUntil(t, bob, func(ev *chatpb.ChatEvent) bool { return ev.GetAck() != nil }) {
+		if r := ev.GetReadReceipt(); r != nil {
+			if r.Username != "alice" || r.UserId != "id-alice" {
+				t.Fatalf("read receipt = %v, want alice", r)
+			}
+			receipts = append(receipts, r.MessageId)
+		}
+	}
+	if want := []string{msgID(2), msgID(3)}; !slices.Equal(receipts, want) {
+		t.Fatalf("read receipts up to %v, want %v", receipts, want)
+	}
+}
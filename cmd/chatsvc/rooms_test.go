@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// roomUUID: 방 목록 page token에 들어가는 방 ID (실제 DB처럼 UUID 형식)
+func roomUUID(n int) string {
+	return fmt.Sprintf("10000000-0000-0000-0000-%012d", n)
+}
+
+// 최근 활동(마지막 메시지) 순으로, page token을 따라가면 모든 방이 한 번씩 나와야 함
+func TestGetMyRoomsPagesByActivity(t *testing.T) {
+	repo := newMemRepo("alice", "bob")
+	for n := 1; n <= 5; n++ {
+		repo.setMembers(roomUUID(n), "alice", "bob")
+	}
+	repo.setMembers(roomUUID(6), "bob") // alice가 멤버가 아닌 방
+	for _, n := range []int{3, 1, 5, 6, 4} {
+		repo.SaveMessage(context.Background(), roomUUID(n), "id-bob", "bob", fmt.Sprintf("hi in %d", n), "")
+	}
+	_, client := startChatServer(t, config.Default().ChatService, repo, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var got []string
+	token := ""
+	for page := 0; ; page++ {
+		resp, err := client.GetMyRooms(asUser(t, ctx, "alice"), &chatpb.GetMyRoomsRequest{Limit: 2, PageToken: token})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Rooms) > 2 {
+			t.Fatalf("page %d has %d rooms, want at most 2", page, len(resp.Rooms))
+		}
+		for _, r := range resp.Rooms {
+			got = append(got, r.RoomId)
+			if r.LastMessageId != "" && (r.LastMessageUsername != "bob" || r.LastMessagePreview == "" || !r.LastActivityAt.AsTime().Equal(r.LastMessageAt.AsTime())) {
+				t.Errorf("room %s preview = %v, want bob's last message", r.RoomId, r)
+			}
+		}
+		if token = resp.NextPageToken; token == "" {
+			break
+		}
+	}
+	// 메시지가 없는 방(2)은 맨 뒤
+	want := []string{roomUUID(4), roomUUID(5), roomUUID(1), roomUUID(3), roomUUID(2)}
+	if !slices.Equal(got, want) {
+		t.Fatalf("rooms = %v, want %v", got, want)
+	}
+}
+
+func TestGetMyRoomsRejectsInvalidPageToken(t *testing.T) {
+	_, client := startChatServer(t, config.Default().ChatService, newMemRepo("alice"), nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	raw := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+	for _, token := range []string{"%%%", raw("1714554000000000"), raw("1714554000000000:room")} {
+		if _, err := client.GetMyRooms(asUser(t, ctx, "alice"), &chatpb.GetMyRoomsRequest{PageToken: token}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GetMyRooms(page_token=%q): err = %v, want InvalidArgument", token, err)
+		}
+	}
+}
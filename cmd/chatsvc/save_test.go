@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/broker"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/user"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// faultRepo: SaveMessage만 구현한 ChatRepository 가짜 (saveErr가 있으면 저장 실패)
+// 나머지 메서드는 nil 인터페이스를 그대로 노출하므로 호출되면 테스트가 panic으로 실패함
+type faultRepo struct {
+	user.ChatRepository
+	saveErr error
+	saved   map[string]*user.MessageRecord // client_message_id → 저장된 메시지
+}
+
+func newFaultRepo() *faultRepo {
+	return &faultRepo{saved: make(map[string]*user.MessageRecord)}
+}
+
+func (r *faultRepo) SaveMessage(ctx context.Context, roomID, senderID, username, messageContent, clientMsgID string) (*user.MessageRecord, bool, error) {
+	if r.saveErr != nil {
+		return nil, false, r.saveErr
+	}
+	if existing, ok := r.saved[clientMsgID]; ok && clientMsgID != "" {
+		return existing, true, nil
+	}
+	record := &user.MessageRecord{
+		ID:             fmt.Sprintf("msg-%d", len(r.saved)+1),
+		RoomID:         roomID,
+		SenderID:       senderID,
+		Username:       username,
+		MessageContent: messageContent,
+		SentAt:         time.Now(),
+		ClientMsgID:    clientMsgID,
+	}
+	r.saved[clientMsgID] = record
+	return record, false, nil
+}
+
+// fakeStream: Context만 쓰는 JoinChat 서버 스트림 (writeLoop는 돌리지 않고 큐를 직접 확인)
+type fakeStream struct {
+	grpc.BidiStreamingServer[chatpb.ChatClientEvent, chatpb.ChatEvent]
+}
+
+func (fakeStream) Context() context.Context { return context.Background() }
+
+func newTestRoom(t *testing.T, repo user.ChatRepository) (*ChatServer, *subscriber, *subscriber) {
+	t.Helper()
+	s := NewChatServer(config.Default().ChatService, repo, broker.NewMemory())
+	sender := newSubscriber(fakeStream{}, "u1", "alice", 16)
+	peer := newSubscriber(fakeStream{}, "u2", "bob", 16)
+	s.addSubscriber("room", sender)
+	s.addSubscriber("room", peer)
+	t.Cleanup(func() {
+		s.removeSubscriber("room", sender)
+		s.removeSubscriber("room", peer)
+	})
+	return s, sender, peer
+}
+
+// drain: 큐에 쌓인 이벤트를 모두 꺼냄
+func drain(sub *subscriber) []*chatpb.ChatEvent {
+	var events []*chatpb.ChatEvent
+	for {
+		select {
+		case ev := <-sub.queue:
+			events = append(events, ev)
+		default:
+			return events
+		}
+	}
+}
+
+func TestSaveAndBroadcastAcksBeforeBroadcast(t *testing.T) {
+	s, sender, peer := newTestRoom(t, newFaultRepo())
+
+	s.saveAndBroadcast(sender, "room", &chatpb.ChatMessage{Message: "hi", ClientMessageId: "c1"})
+
+	got := drain(sender)
+	if len(got) != 2 {
+		t.Fatalf("sender got %d events, want ack + message", len(got))
+	}
+	ack := got[0].GetAck()
+	if ack == nil || ack.ClientMessageId != "c1" || ack.Duplicate || ack.Message.GetMessageId() == "" {
+		t.Fatalf("first sender event = %v, want ack with server ID", got[0])
+	}
+	if msg := got[1].GetMessage(); msg == nil || msg.MessageId != ack.Message.MessageId {
+		t.Fatalf("second sender event = %v, want broadcast of the acked message", got[1])
+	}
+
+	peerEvents := drain(peer)
+	if len(peerEvents) != 1 || peerEvents[0].GetMessage().GetMessageId() != ack.Message.MessageId {
+		t.Fatalf("peer got %v, want the stored message only", peerEvents)
+	}
+}
+
+func TestSaveAndBroadcastNacksWithoutBroadcastOnSaveFailure(t *testing.T) {
+	repo := newFaultRepo()
+	repo.saveErr = errors.New("injected: connection reset")
+	s, sender, peer := newTestRoom(t, repo)
+
+	s.saveAndBroadcast(sender, "room", &chatpb.ChatMessage{Message: "hi", ClientMessageId: "c1"})
+
+	got := drain(sender)
+	if len(got) != 1 {
+		t.Fatalf("sender got %d events, want a single nack", len(got))
+	}
+	nack := got[0].GetNack()
+	if nack == nil || nack.ClientMessageId != "c1" || codes.Code(nack.Code) != codes.Unavailable || !nack.Retryable {
+		t.Fatalf("sender event = %v, want retryable Unavailable nack", got[0])
+	}
+	if peerEvents := drain(peer); len(peerEvents) != 0 {
+		t.Fatalf("peer got %v, want nothing for an unsaved message", peerEvents)
+	}
+}
+
+func TestSaveAndBroadcastDuplicateIsAckedOnly(t *testing.T) {
+	s, sender, peer := newTestRoom(t, newFaultRepo())
+
+	msg := &chatpb.ChatMessage{Message: "hi", ClientMessageId: "c1"}
+	s.saveAndBroadcast(sender, "room", msg)
+	first := drain(sender)[0].GetAck()
+	drain(peer)
+
+	s.saveAndBroadcast(sender, "room", msg)
+
+	got := drain(sender)
+	if len(got) != 1 {
+		t.Fatalf("sender got %d events, want a single duplicate ack", len(got))
+	}
+	if ack := got[0].GetAck(); ack == nil || !ack.Duplicate || ack.Message.GetMessageId() != first.Message.MessageId {
+		t.Fatalf("sender event = %v, want duplicate ack of the first message", got[0])
+	}
+	if peerEvents := drain(peer); len(peerEvents) != 0 {
+		t.Fatalf("peer got %v, want no re-broadcast of a duplicate", peerEvents)
+	}
+}
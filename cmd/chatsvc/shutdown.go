@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 종료 중인 서버의 JoinChat이 끝날 때 돌려주는 상태 (클라이언트는 다른 인스턴스로 재접속)
+var errServerGoingAway = status.Error(codes.Unavailable, "server is shutting down, reconnect with resume_cursor")
+
+// goAway: 종료 알림을 보내고, 큐에 쌓인 이벤트를 다 보낸 뒤 스트림을 끝냄
+// 큐가 꽉 차서 못 넣으면 바로 끝냄
+func (sub *subscriber) goAway(roomID string) {
+	ev := &chatpb.ChatEvent{
+		RoomId: roomID,
+		Event:  &chatpb.ChatEvent_GoingAway{GoingAway: &chatpb.ServerGoingAwayEvent{Reason: "server shutting down"}},
+	}
+	select {
+	case sub.queue <- ev:
+	default:
+		sub.close(errServerGoingAway)
+		return
+	}
+	select {
+	case sub.queue <- nil:
+	default:
+		sub.close(errServerGoingAway)
+	}
+}
+
+// beginInflight: 메시지 저장을 시작해도 되는지 (종료 중이면 false)
+// true면 끝날 때 s.inflight.Done()을 불러야 함
+func (s *ChatServer) beginInflight() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.draining {
+		return false
+	}
+	s.inflight.Add(1)
+	return true
+}
+
+// Shutdown: 새 접속/메시지를 막고, 진행 중인 메시지 저장이 끝날 때까지(최대 ctx 기한) 기다린 뒤
+// 접속 중인 모든 스트림에 종료 알림을 보냄
+// 저장을 먼저 기다려야 그 ack가 종료 알림보다 먼저 큐에 들어가 클라이언트에 전달됨
+// DB 풀은 이 함수가 끝난 뒤에 닫아야 함
+func (s *ChatServer) Shutdown(ctx context.Context) {
+	s.mu.Lock()
+	s.draining = true
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.inflight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		log.Println("진행 중인 메시지 저장을 기다리다 제한 시간을 넘었습니다.")
+	}
+
+	// draining 이후로는 새로 등록되는 스트림이 없음
+	s.mu.RLock()
+	var subs []*subscriber
+	var rooms []string
+	for roomID, clients := range s.clients {
+		for _, c := range clients {
+			subs = append(subs, c)
+			rooms = append(rooms, roomID)
+		}
+	}
+	s.mu.RUnlock()
+
+	log.Printf("접속 중인 스트림 %d개에 종료 알림을 보냅니다.", len(subs))
+	for i, sub := range subs {
+		sub.goAway(rooms[i])
+	}
+}
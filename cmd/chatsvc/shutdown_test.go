@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/broker"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/server"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// shutdownFixture: alice가 JoinChat으로 접속해 있고, 기록 조회가 release 전까지 막힌 GetMessages가 하나 진행 중인 서버
+type shutdownFixture struct {
+	chat    *ChatServer
+	client  chatpb.ChatServiceClient
+	stream  chatpb.ChatService_JoinChatClient
+	pending chan error    // 막힌 GetMessages의 결과
+	release chan struct{} // 닫으면 막힌 조회가 끝남
+	stop    func(ctx context.Context)
+}
+
+func newShutdownFixture(t *testing.T, ctx context.Context) *shutdownFixture {
+	t.Helper()
+	repo := newMemRepo("alice", "bob")
+	chat := NewChatServer(config.Default().ChatService, repo, broker.NewMemory())
+	srv, client := serveChat(t, chat)
+	f := &shutdownFixture{
+		chat:    chat,
+		client:  client,
+		pending: make(chan error, 1),
+		release: make(chan struct{}),
+		stop:    func(ctx context.Context) { server.Shutdown(ctx, srv, chat.Shutdown) },
+	}
+	t.Cleanup(func() {
+		select {
+		case <-f.release:
+		default:
+			close(f.release)
+		}
+	})
+
+	f.stream = join(t, ctx, client, "alice", "room")
+	recvUntil(t, f.stream, func(ev *chatpb.ChatEvent) bool { return ev.GetPresence() != nil })
+
+	// 입장 기록 조회가 끝난 뒤에 훅을 걸어 이후의 GetMessages만 막음 (DB 쿼리처럼 ctx가 취소되면 끝남)
+	entered := make(chan struct{})
+	repo.beforeHistory = func(ctx context.Context) {
+		close(entered)
+		select {
+		case <-f.release:
+		case <-ctx.Done():
+		}
+	}
+	bobCtx := asUser(t, ctx, "bob")
+	go func() {
+		_, err := client.GetMessages(bobCtx, &chatpb.GetMessagesRequest{RoomId: "room"})
+		f.pending <- err
+	}()
+	<-entered
+	return f
+}
+
+// shutdown: 별도 goroutine에서 종료를 시작하고 끝나면 닫히는 채널을 돌려줌
+func (f *shutdownFixture) shutdown(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		f.stop(ctx)
+		close(done)
+	}()
+	return done
+}
+
+func TestShutdownDrainsStreamsAndWaitsForPendingCalls(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	f := newShutdownFixture(t, ctx)
+	done := f.shutdown(ctx)
+
+	// 접속 중인 스트림은 종료 알림을 받은 뒤 Unavailable로 끝남
+	recvUntil(t, f.stream, func(ev *chatpb.ChatEvent) bool { return ev.GetGoingAway() != nil })
+	if _, err := f.stream.Recv(); status.Code(err) != codes.Unavailable {
+		t.Fatalf("stream err after GoingAway = %v, want Unavailable", err)
+	}
+
+	// 종료 중에 들어온 새 호출은 거부
+	stream, err := f.client.JoinChat(asUser(t, ctx, "bob"))
+	if err == nil {
+		stream.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: &chatpb.ChatMessage{Roomid: "room"}}})
+		_, err = stream.Recv()
+	}
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("JoinChat during shutdown: err = %v, want Unavailable", err)
+	}
+
+	// 진행 중인 unary 호출이 끝나기 전에는 반환하지 않음
+	select {
+	case <-done:
+		t.Fatal("Shutdown returned while a call was still in flight")
+	case <-time.After(200 * time.Millisecond):
+	}
+	close(f.release)
+	if err := <-f.pending; err != nil {
+		t.Fatalf("in-flight GetMessages failed: %v", err)
+	}
+	select {
+	case <-done:
+	case <-ctx.Done():
+		t.Fatal("Shutdown did not return after the in-flight call finished")
+	}
+}
+
+func TestShutdownGivesUpWhenContextExpires(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	f := newShutdownFixture(t, ctx)
+
+	const timeout = 300 * time.Millisecond
+	shutdownCtx, cancelShutdown := context.WithTimeout(ctx, timeout)
+	defer cancelShutdown()
+	start := time.Now()
+	select {
+	case <-f.shutdown(shutdownCtx):
+	case <-ctx.Done():
+		t.Fatal("Shutdown did not return after its context expired")
+	}
+	if elapsed := time.Since(start); elapsed < timeout {
+		t.Fatalf("Shutdown returned after %s, before the %s deadline", elapsed, timeout)
+	}
+	// 강제로 끊긴 호출은 실패로 끝남
+	if err := <-f.pending; status.Code(err) != codes.Unavailable {
+		t.Fatalf("in-flight GetMessages err = %v, want Unavailable after forced stop", err)
+	}
+}
+
+// 종료가 시작될 때 저장 중이던 메시지의 ack는 클라이언트가 송신을 끝냈어도(half-close) 종료 알림보다 먼저 와야 함
+func TestShutdownDeliversPendingAckBeforeGoingAway(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	repo := newMemRepo("alice")
+	chat := NewChatServer(config.Default().ChatService, repo, broker.NewMemory())
+	srv, client := serveChat(t, chat)
+
+	stream := join(t, ctx, client, "alice", "room")
+	recvUntil(t, stream, func(ev *chatpb.ChatEvent) bool { return ev.GetPresence() != nil })
+
+	saving, release := make(chan struct{}), make(chan struct{})
+	repo.beforeSave = func(context.Context) {
+		close(saving)
+		<-release
+	}
+	if err := stream.Send(&chatpb.ChatClientEvent{Event: &chatpb.ChatClientEvent_Message{Message: &chatpb.ChatMessage{
+		Message: "last words", ClientMessageId: "c1",
+	}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+	<-saving
+
+	done := make(chan struct{})
+	go func() {
+		server.Shutdown(ctx, srv, chat.Shutdown)
+		close(done)
+	}()
+	// 저장이 끝나기 전에는 종료 알림을 보내지 않음
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+
+	events, err := recvErr(stream)
+	ack, goingAway := -1, -1
+	for i, ev := range events {
+		if ev.GetAck().GetClientMessageId() == "c1" {
+			ack = i
+		}
+		if ev.GetGoingAway() != nil {
+			goingAway = i
+		}
+	}
+	if ack < 0 {
+		t.Fatalf("stream ended (%v) without the ack of the pending message: %v", err, events)
+	}
+	if goingAway >= 0 && goingAway < ack {
+		t.Fatalf("GoingAway (#%d) arrived before the ack (#%d)", goingAway, ack)
+	}
+	select {
+	case <-done:
+	case <-ctx.Done():
+		t.Fatal("Shutdown did not return")
+	}
+}
@@ -0,0 +1,353 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/adminpb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// adminCmd: usersvc의 AdminService를 부르는 명령 (users / rooms / messages / audit)
+type adminCmd struct {
+	client adminpb.AdminServiceClient
+	w      io.Writer
+	json   bool // -o json
+}
+
+// JSON 출력: proto 필드 이름 그대로, 빈 값도 모두
+var outputJSON = protojson.MarshalOptions{Multiline: true, Indent: "  ", UseProtoNames: true, EmitUnpopulated: true}
+
+// parseInterspersed: 플래그와 위치 인자가 섞여 있어도 (users search kim -o json) 모두 파싱하고 위치 인자를 돌려줌
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// runAdmin: args[0]은 users / rooms / messages / audit
+func runAdmin(ctx context.Context, client adminpb.AdminServiceClient, w io.Writer, args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("usage: %s <subcommand> ...", args[0])
+	}
+	group, sub := args[0], args[1]
+
+	fs := flag.NewFlagSet(group+" "+sub, flag.ContinueOnError)
+	output := fs.String("o", "table", "출력 형식 (table 또는 json)")
+	limit := fs.Int("limit", 0, "한 번에 가져올 개수 (0이면 서버 기본값)")
+	offset := fs.Int("offset", 0, "건너뛸 개수 (users list/search)")
+	password := fs.String("password", "", "새 비밀번호 (users reset-password, 비우면 임시 비밀번호 발급)")
+	yes := fs.Bool("yes", false, "확인 없이 실행 (users delete)")
+	reason := fs.String("reason", "", "감사 기록에 남길 사유 (차단/강제 로그아웃/내보내기/메시지 삭제)")
+	actor := fs.String("actor", "", "작업한 관리자의 user_id (audit list)")
+	target := fs.String("target", "", "작업 대상 id (audit list)")
+	before := fs.String("before", "", "이 기록 id보다 오래된 것만 (audit list)")
+	pos, err := parseInterspersed(fs, args[2:])
+	if err != nil {
+		return err
+	}
+	if *output != "table" && *output != "json" {
+		return fmt.Errorf("invalid output %q (table, json)", *output)
+	}
+	c := &adminCmd{client: client, w: w, json: *output == "json"}
+
+	need := func(n int, usage string) error {
+		if len(pos) != n {
+			return fmt.Errorf("usage: %s %s %s", group, sub, usage)
+		}
+		return nil
+	}
+
+	switch group + " " + sub {
+	case "users list":
+		if err := need(0, "[--limit n] [--offset n]"); err != nil {
+			return err
+		}
+		return c.listUsers(ctx, &adminpb.ListUsersRequest{Limit: int32(*limit), Offset: int32(*offset)})
+	case "users search":
+		if err := need(1, "<query> [--limit n] [--offset n]"); err != nil {
+			return err
+		}
+		return c.listUsers(ctx, &adminpb.ListUsersRequest{Query: pos[0], Limit: int32(*limit), Offset: int32(*offset)})
+	case "users show":
+		if err := need(1, "<user_id>"); err != nil {
+			return err
+		}
+		resp, err := client.GetUser(ctx, &adminpb.GetUserRequest{UserId: pos[0]})
+		if err != nil {
+			return err
+		}
+		return c.printUser(resp, resp.User)
+	case "users disable", "users enable":
+		if err := need(1, "<user_id> [--reason r]"); err != nil {
+			return err
+		}
+		resp, err := client.SetUserDisabled(ctx, &adminpb.SetUserDisabledRequest{UserId: pos[0], Disabled: sub == "disable", Reason: *reason})
+		if err != nil {
+			return err
+		}
+		return c.printUser(resp, resp.User)
+	case "users logout":
+		if err := need(1, "<user_id> [--reason r]"); err != nil {
+			return err
+		}
+		resp, err := client.ForceLogout(ctx, &adminpb.ForceLogoutRequest{UserId: pos[0], Reason: *reason})
+		if err != nil {
+			return err
+		}
+		return c.done(resp, "revoked %d session(s) of user %s", resp.RevokedSessions, pos[0])
+	case "users role":
+		if err := need(2, "<user_id> <user|moderator|admin>"); err != nil {
+			return err
+		}
+		resp, err := client.SetUserRole(ctx, &adminpb.SetUserRoleRequest{UserId: pos[0], Role: pos[1]})
+		if err != nil {
+			return err
+		}
+		return c.printUser(resp, resp.User)
+	case "users delete":
+		if err := need(1, "<user_id> --yes"); err != nil {
+			return err
+		}
+		if !*yes {
+			return errors.New("deleting a user cannot be undone, pass --yes to confirm")
+		}
+		resp, err := client.DeleteUser(ctx, &adminpb.DeleteUserRequest{UserId: pos[0]})
+		if err != nil {
+			return err
+		}
+		return c.done(resp, "deleted user %s", pos[0])
+	case "users reset-password":
+		if err := need(1, "<user_id> [--password p]"); err != nil {
+			return err
+		}
+		resp, err := client.ResetPassword(ctx, &adminpb.ResetPasswordRequest{UserId: pos[0], NewPassword: *password})
+		if err != nil {
+			return err
+		}
+		if resp.TemporaryPassword != "" {
+			return c.done(resp, "password reset, temporary password: %s", resp.TemporaryPassword)
+		}
+		return c.done(resp, "password reset")
+	case "rooms list":
+		if err := need(1, "<user_id> [--limit n]"); err != nil {
+			return err
+		}
+		return c.listRooms(ctx, pos[0], int32(*limit))
+	case "rooms history":
+		if err := need(1, "<room_id> [--limit n]"); err != nil {
+			return err
+		}
+		return c.dumpHistory(ctx, pos[0], int32(*limit))
+	case "rooms kick":
+		if err := need(2, "<room_id> <username> [--reason r]"); err != nil {
+			return err
+		}
+		resp, err := client.RemoveRoomMember(ctx, &adminpb.RemoveRoomMemberRequest{RoomId: pos[0], Username: pos[1], Reason: *reason})
+		if err != nil {
+			return err
+		}
+		return c.done(resp, "removed %s from room %s", pos[1], pos[0])
+	case "messages delete":
+		if err := need(2, "<room_id> <message_id> [--reason r]"); err != nil {
+			return err
+		}
+		resp, err := client.DeleteMessage(ctx, &adminpb.DeleteMessageRequest{RoomId: pos[0], MessageId: pos[1], Reason: *reason})
+		if err != nil {
+			return err
+		}
+		return c.done(resp, "deleted message %s", pos[1])
+	case "audit list":
+		if err := need(0, "[--actor user_id] [--target id] [--limit n] [--before id]"); err != nil {
+			return err
+		}
+		return c.listAudit(ctx, &adminpb.ListAuditLogRequest{ActorId: *actor, TargetId: *target, Limit: int32(*limit), PageToken: *before})
+	default:
+		return fmt.Errorf("unknown command %q", group+" "+sub)
+	}
+}
+
+func (c *adminCmd) printJSON(m proto.Message) error {
+	data, err := outputJSON.Marshal(m)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(c.w, string(data))
+	return err
+}
+
+// done: 작업 결과 (json이면 응답, table이면 한 줄 메시지)
+func (c *adminCmd) done(resp proto.Message, format string, args ...any) error {
+	if c.json {
+		return c.printJSON(resp)
+	}
+	_, err := fmt.Fprintf(c.w, format+"\n", args...)
+	return err
+}
+
+func formatUnix(sec int64) string {
+	if sec == 0 {
+		return "-"
+	}
+	return time.Unix(sec, 0).Local().Format("2006-01-02 15:04:05")
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func (c *adminCmd) listUsers(ctx context.Context, req *adminpb.ListUsersRequest) error {
+	resp, err := c.client.ListUsers(ctx, req)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJSON(resp)
+	}
+	tw := tabwriter.NewWriter(c.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tUSERNAME\tNICKNAME\tEMAIL\tROLE\tCREATED\tSTATUS")
+	for _, u := range resp.Users {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", u.Id, u.Username, orDash(u.Nickname), u.Email, u.Role, formatUnix(u.CreatedAt), userStatus(u))
+	}
+	return tw.Flush()
+}
+
+func userStatus(u *adminpb.AdminUser) string {
+	if u.Disabled {
+		return "disabled"
+	}
+	return "active"
+}
+
+// printUser: 유저 한 명을 "항목: 값" 형태로
+func (c *adminCmd) printUser(resp proto.Message, u *adminpb.AdminUser) error {
+	if c.json {
+		return c.printJSON(resp)
+	}
+	phone := orDash(u.Phone)
+	if u.Phone != "" && u.PhoneVerified {
+		phone += " (verified)"
+	}
+	tw := tabwriter.NewWriter(c.w, 0, 0, 2, ' ', 0)
+	for _, row := range [][2]string{
+		{"ID", u.Id},
+		{"Username", u.Username},
+		{"Name", u.Name},
+		{"Nickname", orDash(u.Nickname)},
+		{"Email", u.Email},
+		{"Phone", phone},
+		{"Role", u.Role},
+		{"Created", formatUnix(u.CreatedAt)},
+		{"Status", userStatus(u)},
+		{"Disabled at", formatUnix(u.DisabledAt)},
+	} {
+		fmt.Fprintf(tw, "%s:\t%s\n", row[0], row[1])
+	}
+	return tw.Flush()
+}
+
+// listRooms: 유저의 방 목록 전체 (page_token으로 끝까지)
+func (c *adminCmd) listRooms(ctx context.Context, userID string, limit int32) error {
+	all := &adminpb.ListUserRoomsResponse{}
+	req := &adminpb.ListUserRoomsRequest{UserId: userID, Limit: limit}
+	for {
+		resp, err := c.client.ListUserRooms(ctx, req)
+		if err != nil {
+			return err
+		}
+		all.Rooms = append(all.Rooms, resp.Rooms...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	if c.json {
+		return c.printJSON(all)
+	}
+
+	tw := tabwriter.NewWriter(c.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ROOM_ID\tTYPE\tTITLE\tMEMBERS\tLAST_ACTIVITY\tLAST_MESSAGE")
+	for _, r := range all.Rooms {
+		kind := "direct"
+		if r.IsGroup {
+			kind = "group"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s\t%s\n", r.RoomId, kind, orDash(r.Title), r.MemberCount, formatUnix(r.LastActivityAt), orDash(oneLine(r.LastMessagePreview)))
+	}
+	return tw.Flush()
+}
+
+// dumpHistory: 방의 모든 메시지를 오래된 순으로 (limit은 한 번에 받을 페이지 크기)
+func (c *adminCmd) dumpHistory(ctx context.Context, roomID string, limit int32) error {
+	all := &adminpb.GetRoomMessagesResponse{}
+	req := &adminpb.GetRoomMessagesRequest{RoomId: roomID, Limit: limit}
+	for {
+		resp, err := c.client.GetRoomMessages(ctx, req)
+		if err != nil {
+			return err
+		}
+		all.Messages = append(all.Messages, resp.Messages...)
+		if !resp.HasMore {
+			break
+		}
+		req.Cursor = resp.NextCursor
+	}
+	if c.json {
+		return c.printJSON(all)
+	}
+
+	tw := tabwriter.NewWriter(c.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SENT_AT\tUSERNAME\tMESSAGE_ID\tMESSAGE")
+	for _, m := range all.Messages {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", formatUnix(m.SentAt), m.Username, m.MessageId, oneLine(m.Message))
+	}
+	return tw.Flush()
+}
+
+// listAudit: 감사 기록 한 페이지 (최근순, 더 오래된 기록이 있으면 다음 페이지를 보는 --before 값을 안내)
+func (c *adminCmd) listAudit(ctx context.Context, req *adminpb.ListAuditLogRequest) error {
+	resp, err := c.client.ListAuditLog(ctx, req)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJSON(resp)
+	}
+
+	tw := tabwriter.NewWriter(c.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tTIME\tACTOR\tROLE\tACTION\tTARGET\tDETAIL")
+	for _, e := range resp.Entries {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s:%s\t%s\n", e.Id, formatUnix(e.CreatedAt), e.ActorUsername, e.ActorRole, e.Action, e.TargetType, e.TargetId, e.Detail)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if resp.NextPageToken != "" {
+		_, err = fmt.Fprintf(c.w, "(older entries: audit list --before %s)\n", resp.NextPageToken)
+	}
+	return err
+}
+
+// oneLine: 표가 깨지지 않도록 줄바꿈/탭을 공백으로
+func oneLine(s string) string {
+	return strings.NewReplacer("\r\n", " ", "\n", " ", "\t", " ").Replace(s)
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/adminpb"
+	"google.golang.org/grpc"
+)
+
+// fakeAdminClient: 쓰는 메서드만 구현한 AdminServiceClient (나머지는 nil 임베드라 부르면 panic)
+type fakeAdminClient struct {
+	adminpb.AdminServiceClient
+	users    []*adminpb.AdminUser
+	messages []*adminpb.AdminMessage
+	deleted  []string
+	lastList *adminpb.ListUsersRequest
+}
+
+func (f *fakeAdminClient) ListUsers(ctx context.Context, req *adminpb.ListUsersRequest, _ ...grpc.CallOption) (*adminpb.ListUsersResponse, error) {
+	f.lastList = req
+	return &adminpb.ListUsersResponse{Users: f.users}, nil
+}
+
+func (f *fakeAdminClient) DeleteUser(ctx context.Context, req *adminpb.DeleteUserRequest, _ ...grpc.CallOption) (*adminpb.DeleteUserResponse, error) {
+	f.deleted = append(f.deleted, req.UserId)
+	return &adminpb.DeleteUserResponse{}, nil
+}
+
+// GetRoomMessages: 메시지를 limit개씩 잘라서 페이지로 돌려줌 (cursor = 다음 인덱스)
+func (f *fakeAdminClient) GetRoomMessages(ctx context.Context, req *adminpb.GetRoomMessagesRequest, _ ...grpc.CallOption) (*adminpb.GetRoomMessagesResponse, error) {
+	start := 0
+	if req.Cursor != "" {
+		start = int(req.Cursor[0] - '0')
+	}
+	end := min(start+int(req.Limit), len(f.messages))
+	resp := &adminpb.GetRoomMessagesResponse{Messages: f.messages[start:end]}
+	if end < len(f.messages) {
+		resp.HasMore = true
+		resp.NextCursor = string(rune('0' + end))
+	}
+	return resp, nil
+}
+
+func run(t *testing.T, client adminpb.AdminServiceClient, args ...string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	err := runAdmin(context.Background(), client, &buf, args)
+	return buf.String(), err
+}
+
+func TestAdminUsersOutput(t *testing.T) {
+	client := &fakeAdminClient{users: []*adminpb.AdminUser{
+		{Id: "u1", Username: "alice", Email: "alice@example.com", Nickname: "앨리스"},
+		{Id: "u2", Username: "bob", Email: "bob@example.com", Disabled: true, DisabledAt: 1700000000},
+	}}
+
+	out, err := run(t, client, "users", "search", "a", "--limit", "5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.lastList.Query != "a" || client.lastList.Limit != 5 {
+		t.Errorf("request = %v, want query a, limit 5", client.lastList)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "ID") || !strings.Contains(lines[1], "alice") || !strings.Contains(lines[2], "disabled") {
+		t.Errorf("table output:\n%s", out)
+	}
+
+	// 플래그가 위치 인자 뒤에 와도 됨, JSON은 proto 필드 이름 그대로
+	out, err = run(t, client, "users", "list", "-o", "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp struct {
+		Users []map[string]any `json:"users"`
+	}
+	if err := json.Unmarshal([]byte(out), &resp); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if len(resp.Users) != 2 || resp.Users[1]["disabled"] != true || resp.Users[0]["phone"] != "" {
+		t.Errorf("json output = %v", resp.Users)
+	}
+
+	if _, err := run(t, client, "users", "list", "-o", "yaml"); err == nil {
+		t.Error("unknown output format accepted")
+	}
+	if _, err := run(t, client, "users", "show"); err == nil {
+		t.Error("users show without user_id accepted")
+	}
+}
+
+func TestAdminDeleteRequiresConfirmation(t *testing.T) {
+	client := &fakeAdminClient{}
+	if _, err := run(t, client, "users", "delete", "u1"); err == nil || len(client.deleted) != 0 {
+		t.Fatalf("delete without --yes: err = %v, deleted = %v", err, client.deleted)
+	}
+	out, err := run(t, client, "users", "delete", "u1", "--yes")
+	if err != nil || len(client.deleted) != 1 || !strings.Contains(out, "deleted user u1") {
+		t.Fatalf("delete --yes: out %q err %v deleted %v", out, err, client.deleted)
+	}
+}
+
+func TestAdminRoomHistoryFollowsCursor(t *testing.T) {
+	client := &fakeAdminClient{}
+	for _, text := range []string{"one", "two", "three\nlines", "four", "five"} {
+		client.messages = append(client.messages, &adminpb.AdminMessage{MessageId: "m-" + text[:3], Username: "alice", Message: text})
+	}
+
+	out, err := run(t, client, "rooms", "history", "room-1", "--limit", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 6 || !strings.HasSuffix(lines[3], "three lines") || !strings.HasSuffix(lines[5], "five") {
+		t.Errorf("history over 3 pages:\n%s", out)
+	}
+}
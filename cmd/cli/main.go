@@ -2,6 +2,261 @@
 
 package main
 
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/db"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/server"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/user"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/adminpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const usage = `사용법: cli [설정 플래그] <명령>
+
+명령:
+  migrate up          아직 적용하지 않은 마이그레이션을 모두 적용
+  migrate down [n]    최근 마이그레이션 n개(기본 1)를 되돌림
+  migrate status      마이그레이션 적용 상태 출력
+  keys generate [alg] auth.signing_keys_dir에 새 서명 키 생성 (ed25519 기본, rsa)
+  keys retire <kid>   개인 키를 공개 키로 바꿔 검증에만 쓰이게 함
+  keys jwks           공개 키 문서(JWKS) 출력
+  certs dev [dir] [host...]
+                      개발용 CA와 서버/클라이언트 겸용 인증서 생성 (기본 certs/, CA가 있으면 그대로 씀)
+
+관리자 명령 (usersvc의 AdminService에 admin.token(ADMIN_TOKEN) 또는
+moderator/admin 유저의 access token(ADMIN_ACCESS_TOKEN)으로 접속, [admin]은 admin 역할만):
+  users list [--limit n] [--offset n]      최근 가입순 유저 목록
+  users search <검색어> [--limit n]         username/nickname 검색
+  users show <user_id>                     유저 정보
+  users disable <user_id> [--reason r]     계정 차단 (모든 세션과 채팅 연결 끊김)
+  users enable <user_id>                   차단 해제
+  users logout <user_id> [--reason r]      강제 로그아웃 (모든 세션과 채팅 연결 끊김)
+  users role <user_id> <role>              [admin] 역할 변경 (user, moderator, admin)
+  users delete <user_id> --yes             [admin] 유저 삭제
+  users reset-password <user_id> [--password p]
+                                           [admin] 비밀번호 초기화 (비우면 임시 비밀번호 발급)
+  rooms list <user_id>                     유저가 속한 방 목록
+  rooms history <room_id>                  방의 전체 대화 기록 (오래된 순)
+  rooms kick <room_id> <username> [--reason r]
+                                           그룹 방에서 멤버 내보내기
+  messages delete <room_id> <message_id> [--reason r]
+                                           메시지 삭제
+  audit list [--actor user_id] [--target id] [--limit n] [--before id]
+                                           [admin] 관리자 작업 기록 (최근순)
+
+  관리자 명령은 모두 -o json으로 JSON 출력 (기본 -o table)
+  세션을 끊거나 방에서 내보내면 접속 중인 채팅 연결은 chatsvc.access_check_interval 안에 닫힘
+  (그 전에 보낸 메시지도 저장되지 않음)
+`
+
 func main() {
-	println("Admin CLI tool")
+	// 명령마다 필요한 설정이 달라서 여기서는 admin 섹션만 검증하고, 나머지는 명령별로 확인
+	cfg, args := config.MustLoadArgs("admin", "tls")
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	switch args[0] {
+	case "migrate":
+		if err := cfg.Validate("database"); err != nil {
+			log.Fatalf("Invalid config:\n%v", err)
+		}
+		// 명령으로 직접 적용하므로 Init에서는 자동 적용하지 않음
+		cfg.Database.AutoMigrate = false
+		db.Init(cfg.Database)
+		defer db.Pool.Close()
+		if err := runMigrate(context.Background(), args[1:]); err != nil {
+			db.Pool.Close()
+			log.Fatalf("migrate: %v", err)
+		}
+	case "keys":
+		if err := runKeys(cfg.Auth, args[1:]); err != nil {
+			log.Fatalf("keys: %v", err)
+		}
+	case "certs":
+		if err := runCerts(args[1:]); err != nil {
+			log.Fatalf("certs: %v", err)
+		}
+	case "users", "rooms", "messages", "audit":
+		if err := withAdminClient(cfg.Admin, cfg.TLS, func(ctx context.Context, client adminpb.AdminServiceClient) error {
+			return runAdmin(ctx, client, os.Stdout, args)
+		}); err != nil {
+			log.Fatalf("%s: %v", args[0], err)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "알 수 없는 명령: %s\n\n%s", args[0], usage)
+		os.Exit(2)
+	}
+}
+
+// withAdminClient: 관리자 토큰(없으면 유저 access token)을 metadata에 붙인 context와 AdminService 클라이언트로 fn을 실행
+func withAdminClient(cfg config.AdminConfig, tlsCfg config.TLSConfig, fn func(ctx context.Context, client adminpb.AdminServiceClient) error) error {
+	if cfg.Token == "" && cfg.AccessToken == "" {
+		return fmt.Errorf("admin.token (ADMIN_TOKEN) or admin.access_token (ADMIN_ACCESS_TOKEN) is required")
+	}
+	creds, err := server.DialCredentials(tlsCfg)
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.NewClient(cfg.UserServiceAddr, creds)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// 여러 페이지를 받는 명령(rooms history 등)도 전체가 timeout 안에 끝나야 함
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+	defer cancel()
+	if cfg.Token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, user.AdminTokenHeader, cfg.Token)
+	} else {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+cfg.AccessToken)
+	}
+	return fn(ctx, adminpb.NewAdminServiceClient(conn))
+}
+
+// runMigrate: migrate up / down [n] / status
+func runMigrate(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing subcommand (up, down, status)")
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := db.MigrateUp(ctx, db.Pool)
+		for _, m := range applied {
+			fmt.Printf("up   %04d_%s\n", m.Version, m.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("already up to date")
+		}
+		return err
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid step count %q", args[1])
+			}
+			steps = n
+		}
+		reverted, err := db.MigrateDown(ctx, db.Pool, steps)
+		for _, m := range reverted {
+			fmt.Printf("down %04d_%s\n", m.Version, m.Name)
+		}
+		if err == nil && len(reverted) == 0 {
+			fmt.Println("nothing to revert")
+		}
+		return err
+
+	case "status":
+		states, err := db.MigrationStatus(ctx, db.Pool)
+		if err != nil {
+			return err
+		}
+		for _, st := range states {
+			switch {
+			case st.Unknown:
+				fmt.Printf("%04d_%-28s applied %s (unknown to this build)\n", st.Version, st.Name, st.AppliedAt.Format("2006-01-02 15:04:05"))
+			case st.AppliedAt != nil && st.Irreversible:
+				fmt.Printf("%04d_%-28s applied %s (irreversible)\n", st.Version, st.Name, st.AppliedAt.Format("2006-01-02 15:04:05"))
+			case st.AppliedAt != nil:
+				fmt.Printf("%04d_%-28s applied %s\n", st.Version, st.Name, st.AppliedAt.Format("2006-01-02 15:04:05"))
+			default:
+				fmt.Printf("%04d_%-28s pending\n", st.Version, st.Name)
+			}
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown subcommand %q (up, down, status)", args[0])
+	}
+}
+
+// runKeys: keys generate / retire / jwks
+// 새 키는 서비스를 다시 시작하면 서명 키가 되고, 이전 키는 access token 유효 기간이 지난 뒤 retire
+func runKeys(cfg config.AuthConfig, args []string) error {
+	if cfg.SigningKeysDir == "" {
+		return fmt.Errorf("auth.signing_keys_dir (JWT_SIGNING_KEYS_DIR) is not set")
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("missing subcommand (generate, retire, jwks)")
+	}
+
+	switch args[0] {
+	case "generate":
+		alg := ""
+		if len(args) > 1 {
+			alg = args[1]
+		}
+		kid, err := user.GenerateSigningKey(cfg.SigningKeysDir, alg)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("generated %s/%s.pem\n", cfg.SigningKeysDir, kid)
+		return nil
+
+	case "retire":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: keys retire <kid>")
+		}
+		if err := user.RetireSigningKey(cfg.SigningKeysDir, args[1]); err != nil {
+			return err
+		}
+		fmt.Printf("retired %s (verification only)\n", args[1])
+		return nil
+
+	case "jwks":
+		ks, err := user.LoadKeyDir(cfg.SigningKeysDir, cfg.SigningKeyID)
+		if err != nil {
+			return err
+		}
+		doc, err := ks.JWKS()
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(doc))
+		return nil
+
+	default:
+		return fmt.Errorf("unknown subcommand %q (generate, retire, jwks)", args[0])
+	}
+}
+
+// runCerts: certs dev [dir] [host...]
+// 로컬에서 TLS/mTLS를 켜 볼 수 있도록 개발용 CA와 인증서를 만들고, 쓸 환경 변수를 출력함
+func runCerts(args []string) error {
+	if len(args) == 0 || args[0] != "dev" {
+		return fmt.Errorf("usage: certs dev [dir] [host...]")
+	}
+	dir := "certs"
+	if len(args) > 1 {
+		dir = args[1]
+	}
+	hosts := slices.Concat(server.DevCertHosts, args[min(len(args), 2):])
+
+	files, err := server.GenerateDevCerts(dir, "dev", hosts)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("generated %s (CA), %s (%s)\n\n", files.CAFile, files.CertFile, strings.Join(hosts, ", "))
+	fmt.Println("# 서비스 / 게이트웨이 / 클라이언트 공통 (.env 등에 추가)")
+	fmt.Println("TLS_ENABLED=true")
+	fmt.Printf("TLS_CA_FILE=%s\n", files.CAFile)
+	fmt.Printf("TLS_CERT_FILE=%s\n", files.CertFile)
+	fmt.Printf("TLS_KEY_FILE=%s\n", files.KeyFile)
+	fmt.Println("# usersvc / chatsvc가 게이트웨이의 클라이언트 인증서를 요구하려면 (mTLS)")
+	fmt.Println("# TLS_CLIENT_AUTH=require")
+	return nil
 }
@@ -4,42 +4,82 @@ package main
 import (
 	"log"
 	"net"
+	"net/http"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
 	"github.com/Dorazi23/gRPC_Chat_Project/internal/db"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/server"
 	"github.com/Dorazi23/gRPC_Chat_Project/internal/user"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/adminpb"
 	"github.com/Dorazi23/gRPC_Chat_Project/pkg/userpb"
 )
 
 func main() {
-	// 0. DB 연결
-	db.Init()
+	// 0. 설정 로드 (--print-config면 출력 후 종료)
+	cfg := config.MustLoad()
+	if err := user.ConfigureAuth(cfg.Auth); err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+	user.ConfigureAdmin(cfg.Admin.Token)
+
+	// DB 연결 (서버가 완전히 멈춘 뒤에 닫음)
+	db.Init(cfg.Database)
+	defer db.Pool.Close()
 
 	// 1. 포트 리슨
-	lis, err := net.Listen("tcp", ":50051")
+	lis, err := net.Listen("tcp", cfg.UserService.ListenAddr)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	// 2. gRPC 서버 생성
+	// 2. gRPC 서버 생성 (tls.enabled면 TLS, 인증서 파일이 바뀌면 새 연결부터 새 인증서로)
+	creds, err := server.ServerCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("failed to load TLS config: %v", err)
+	}
 	grpcServer := grpc.NewServer(
+		creds,
 		grpc.UnaryInterceptor(user.UnaryAuthInterceptor),
 	)
 
-	// 3. 유저 서비스 의존성 구성
-	svc := user.NewService(db.Pool)
+	// 3. 유저 서비스 의존성 구성 (인터셉터는 토큰마다 세션이 끊기지 않았는지 확인)
+	smsSender, err := user.NewSMSSender(cfg.Phone)
+	if err != nil {
+		log.Fatalf("failed to create sms sender: %v", err)
+	}
+	phoneVerifier := user.NewPhoneVerifier(user.NewPhoneVerificationStore(db.Pool), smsSender, cfg.Phone)
+	svc := user.NewService(db.Pool, phoneVerifier, user.NewIdentityProviders(cfg.Social))
+	user.ConfigureSessions(user.NewSessionChecker(db.Pool))
 	handler := user.NewHandler(svc)
 
-	// 4. gRPC 서버에 UserService 등록
+	// 4. gRPC 서버에 UserService / AdminService 등록
+	// AdminService는 moderator/admin 역할의 토큰이나 admin.token으로 쓸 수 있고, 작업은 감사 기록에 남음
 	userpb.RegisterUserServiceServer(grpcServer, handler)
+	adminpb.RegisterAdminServiceServer(grpcServer, user.NewAdminHandler(svc, user.NewChatRepository(db.Pool), user.NewAuditLog(db.Pool)))
+	if cfg.Admin.Token == "" {
+		log.Println("admin.token(ADMIN_TOKEN)이 없어 관리자 API는 moderator/admin 역할의 토큰으로만 쓸 수 있습니다.")
+	}
 	reflection.Register(grpcServer)
 
-	log.Println("UserService gRPC server listening on :50051")
+	// 다른 서비스가 개인 키 없이 토큰을 검증할 수 있도록 공개 키 문서(JWKS)를 HTTP로 내보냄
+	if addr := cfg.UserService.JWKSAddr; addr != "" {
+		mux := http.NewServeMux()
+		mux.Handle(user.JWKSPath, user.JWKSHandler())
+		go func() {
+			log.Printf("JWKS: http://%s%s", addr, user.JWKSPath)
+			if err := http.ListenAndServe(addr, mux); err != nil {
+				log.Printf("JWKS server stopped: %v", err)
+			}
+		}()
+	}
+
+	log.Printf("UserService gRPC server listening on %s", cfg.UserService.ListenAddr)
 
-	// 5. 서버 시작 (무한 루프)
-	if err := grpcServer.Serve(lis); err != nil {
+	// 5. 서버 시작 (SIGINT/SIGTERM을 받으면 진행 중인 요청을 마치고 종료)
+	if err := server.ServeUntilSignal(grpcServer, lis, cfg.UserService.ShutdownTimeout, nil); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
@@ -0,0 +1,82 @@
+package broker
+
+import (
+	"context"
+	"sync"
+)
+
+// Handler는 구독한 방에 발행된 payload를 받는 함수입니다.
+type Handler func(payload []byte)
+
+// Broker는 방(room) 단위 pub/sub 인터페이스입니다.
+// chatsvc 인스턴스가 여러 개일 때 서로의 이벤트를 받기 위해 사용합니다.
+// 발행한 인스턴스 자신도 구독 중이면 같은 payload를 받습니다.
+type Broker interface {
+	// 방에 payload를 발행합니다.
+	Publish(ctx context.Context, roomID string, payload []byte) error
+
+	// 방을 구독합니다. 반환된 함수를 호출하면 구독이 해제됩니다.
+	// handler는 여러 goroutine에서 동시에 호출될 수 있으므로 handler 쪽에서 동기화해야 합니다.
+	Subscribe(roomID string, handler Handler) (unsubscribe func(), err error)
+
+	// 브로커를 닫습니다.
+	Close() error
+}
+
+// ---------------------------
+// 인메모리 브로커 (단일 노드용)
+// ---------------------------
+
+type memoryBroker struct {
+	mu     sync.RWMutex
+	nextID int
+	subs   map[string]map[int]Handler
+}
+
+// NewMemory: 한 프로세스 안에서만 동작하는 브로커를 생성합니다.
+func NewMemory() Broker {
+	return &memoryBroker{subs: make(map[string]map[int]Handler)}
+}
+
+func (b *memoryBroker) Publish(ctx context.Context, roomID string, payload []byte) error {
+	// handler가 다시 Subscribe/unsubscribe를 불러도 막히지 않도록 복사한 뒤 락 밖에서 호출
+	b.mu.RLock()
+	handlers := make([]Handler, 0, len(b.subs[roomID]))
+	for _, h := range b.subs[roomID] {
+		handlers = append(handlers, h)
+	}
+	b.mu.RUnlock()
+
+	for _, h := range handlers {
+		h(payload)
+	}
+	return nil
+}
+
+func (b *memoryBroker) Subscribe(roomID string, handler Handler) (func(), error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	if b.subs[roomID] == nil {
+		b.subs[roomID] = make(map[int]Handler)
+	}
+	b.subs[roomID][id] = handler
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			delete(b.subs[roomID], id)
+			if len(b.subs[roomID]) == 0 {
+				delete(b.subs, roomID)
+			}
+		})
+	}, nil
+}
+
+func (b *memoryBroker) Close() error {
+	return nil
+}
@@ -0,0 +1,69 @@
+package broker
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+// recorder: 받은 payload를 모아두는 handler
+type recorder struct {
+	mu  sync.Mutex
+	got []string
+}
+
+func (r *recorder) handle(payload []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.got = append(r.got, string(payload))
+}
+
+func (r *recorder) received() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.got...)
+}
+
+func TestMemoryBrokerDeliversToRoomSubscribers(t *testing.T) {
+	b := NewMemory()
+	ctx := context.Background()
+	var a1, a2, other recorder
+	unsubA1, _ := b.Subscribe("room-a", a1.handle)
+	b.Subscribe("room-a", a2.handle)
+	b.Subscribe("room-b", other.handle)
+
+	b.Publish(ctx, "room-a", []byte("hello"))
+	unsubA1()
+	unsubA1() // 두 번 불러도 안전
+	b.Publish(ctx, "room-a", []byte("again"))
+
+	if got := a1.received(); len(got) != 1 || got[0] != "hello" {
+		t.Fatalf("unsubscribed handler got %q, want only the first payload", got)
+	}
+	if got := a2.received(); len(got) != 2 {
+		t.Fatalf("subscriber got %q, want both payloads", got)
+	}
+	if got := other.received(); len(got) != 0 {
+		t.Fatalf("subscriber of another room got %q", got)
+	}
+}
+
+// handler 안에서 구독을 해제해도 막히지 않아야 함 (마지막 접속자가 나가면서 구독을 끊는 경우)
+func TestMemoryBrokerHandlerMayUnsubscribe(t *testing.T) {
+	b := NewMemory()
+	var unsubscribe func()
+	calls := 0
+	unsubscribe, _ = b.Subscribe("room", func([]byte) {
+		calls++
+		unsubscribe()
+	})
+
+	b.Publish(context.Background(), "room", []byte("1"))
+	b.Publish(context.Background(), "room", []byte("2"))
+	if calls != 1 {
+		t.Fatalf("handler called %d times, want 1", calls)
+	}
+	if n := len(b.(*memoryBroker).subs); n != 0 {
+		t.Fatalf("%d rooms still subscribed after the last unsubscribe", n)
+	}
+}
@@ -0,0 +1,145 @@
+package broker
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// 모든 방의 이벤트를 하나의 채널로 보내고, 받는 쪽에서 방 ID로 나눠서 전달합니다.
+// (방마다 LISTEN/UNLISTEN을 하면 전용 커넥션에서 대기 중인 WaitForNotification을 계속 끊어야 해서)
+const notifyChannel = "chat_events"
+
+// NOTIFY payload 최대 크기는 8000바이트 (설정 기본값 기준)
+const maxNotifyPayload = 7999
+
+var ErrPayloadTooLarge = errors.New("payload too large for postgres NOTIFY")
+
+// postgresBroker: Postgres LISTEN/NOTIFY 기반 브로커 (여러 chatsvc 인스턴스용)
+// 받은 이벤트의 방별 분배는 인메모리 브로커에 맡깁니다.
+type postgresBroker struct {
+	pool   *pgxpool.Pool
+	local  *memoryBroker
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewPostgres: db.Pool을 그대로 사용하는 LISTEN/NOTIFY 브로커를 생성합니다.
+// LISTEN용 커넥션 하나를 풀에서 떼어내서(hijack) 계속 붙잡고 있습니다.
+func NewPostgres(pool *pgxpool.Pool) Broker {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := &postgresBroker{
+		pool:   pool,
+		local:  &memoryBroker{subs: make(map[string]map[int]Handler)},
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go b.listen(ctx)
+	return b
+}
+
+// MaxPayloadSize: roomID 방에 Publish할 수 있는 payload 최대 크기 (NOTIFY 제한에서 방 ID와 base64 증가분을 뺀 값)
+func MaxPayloadSize(roomID string) int {
+	return (maxNotifyPayload - len(roomID) - 1) / 4 * 3
+}
+
+// encodeNotification: NOTIFY payload는 텍스트여야 하므로 "<방 ID>:<base64 payload>" 형태로 만듦
+func encodeNotification(roomID string, payload []byte) (string, error) {
+	if strings.Contains(roomID, ":") {
+		return "", fmt.Errorf("room ID %q must not contain ':'", roomID)
+	}
+	body := roomID + ":" + base64.StdEncoding.EncodeToString(payload)
+	if len(body) > maxNotifyPayload {
+		return "", ErrPayloadTooLarge
+	}
+	return body, nil
+}
+
+// decodeNotification: encodeNotification의 반대
+func decodeNotification(body string) (string, []byte, error) {
+	roomID, encoded, ok := strings.Cut(body, ":")
+	if !ok {
+		return "", nil, errors.New("missing room ID")
+	}
+	payload, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", nil, err
+	}
+	return roomID, payload, nil
+}
+
+func (b *postgresBroker) Publish(ctx context.Context, roomID string, payload []byte) error {
+	body, err := encodeNotification(roomID, payload)
+	if err != nil {
+		return err
+	}
+
+	if _, err := b.pool.Exec(ctx, `SELECT pg_notify($1, $2)`, notifyChannel, body); err != nil {
+		return fmt.Errorf("failed to notify: %w", err)
+	}
+	return nil
+}
+
+func (b *postgresBroker) Subscribe(roomID string, handler Handler) (func(), error) {
+	return b.local.Subscribe(roomID, handler)
+}
+
+func (b *postgresBroker) Close() error {
+	b.cancel()
+	<-b.done
+	return nil
+}
+
+// listen: LISTEN 커넥션을 유지하면서 알림을 받아 방별 handler에 전달
+// 연결이 끊기면 1초 뒤 다시 연결합니다. (끊긴 동안의 알림은 유실됨)
+func (b *postgresBroker) listen(ctx context.Context) {
+	defer close(b.done)
+
+	for {
+		err := b.listenOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("broker: LISTEN 연결 끊김, 재연결합니다: %v", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (b *postgresBroker) listenOnce(ctx context.Context) error {
+	pooled, err := b.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// LISTEN 상태의 커넥션이 풀로 돌아가면 안 되므로 풀에서 떼어냄
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+notifyChannel); err != nil {
+		return err
+	}
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		roomID, payload, err := decodeNotification(n.Payload)
+		if err != nil {
+			log.Printf("broker: 잘못된 알림 payload 무시: %v", err)
+			continue
+		}
+		b.local.Publish(ctx, roomID, payload)
+	}
+}
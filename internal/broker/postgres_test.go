@@ -0,0 +1,125 @@
+package broker
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func TestNotificationRoundTrip(t *testing.T) {
+	roomID := "6f1c2a9e-5b1d-4c43-9a53-2b0c8f6f7a11"
+	payload := make([]byte, MaxPayloadSize(roomID))
+	rand.Read(payload) // 줄바꿈, NUL 등 모든 바이트 값
+
+	body, err := encodeNotification(roomID, payload)
+	if err != nil {
+		t.Fatalf("encode max-size payload: %v", err)
+	}
+	if len(body) > maxNotifyPayload {
+		t.Fatalf("encoded body is %d bytes, over the %d byte NOTIFY limit", len(body), maxNotifyPayload)
+	}
+	gotRoom, gotPayload, err := decodeNotification(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotRoom != roomID || !bytes.Equal(gotPayload, payload) {
+		t.Fatalf("round trip changed the notification (room %q)", gotRoom)
+	}
+
+	if _, err := encodeNotification(roomID, make([]byte, MaxPayloadSize(roomID)+3)); err != ErrPayloadTooLarge {
+		t.Fatalf("encode oversized payload: err = %v, want ErrPayloadTooLarge", err)
+	}
+	if _, err := encodeNotification("a:b", []byte("x")); err == nil {
+		t.Fatal("encode with ':' in room ID succeeded")
+	}
+	for _, bad := range []string{"no-separator", "room:not base64!"} {
+		if _, _, err := decodeNotification(bad); err == nil {
+			t.Errorf("decode %q succeeded", bad)
+		}
+	}
+}
+
+// testPool: DATABASE_URL의 DB에 application_name을 붙인 풀 (없으면 테스트 건너뜀)
+func testPool(t *testing.T, appName string) *pgxpool.Pool {
+	t.Helper()
+	url := os.Getenv("DATABASE_URL")
+	if url == "" {
+		t.Skip("DATABASE_URL is not set")
+	}
+	cfg, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.ConnConfig.RuntimeParams["application_name"] = appName
+	pool, err := pgxpool.NewWithConfig(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func randomName(prefix string) string {
+	b := make([]byte, 6)
+	rand.Read(b)
+	return prefix + hex.EncodeToString(b)
+}
+
+// publishUntil: LISTEN이 준비될 때까지(재연결 포함) 같은 payload를 반복 발행하고 받을 때까지 기다림
+func publishUntil(t *testing.T, pub Broker, roomID string, payload []byte, got <-chan []byte) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	tick := time.NewTicker(100 * time.Millisecond)
+	defer tick.Stop()
+	for {
+		if err := pub.Publish(ctx, roomID, payload); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+		select {
+		case p := <-got:
+			if !bytes.Equal(p, payload) {
+				t.Fatalf("received %q, want %q", p, payload)
+			}
+			return
+		case <-tick.C:
+		case <-ctx.Done():
+			t.Fatalf("payload %q was not delivered", payload)
+		}
+	}
+}
+
+func TestPostgresBrokerAcrossInstancesAndReconnect(t *testing.T) {
+	listenerApp := randomName("broker-test-")
+	pub := NewPostgres(testPool(t, randomName("broker-test-")))
+	defer pub.Close()
+	sub := NewPostgres(testPool(t, listenerApp))
+	defer sub.Close()
+
+	roomID := randomName("room-")
+	got := make(chan []byte, 16)
+	unsubscribe, err := sub.Subscribe(roomID, func(p []byte) { got <- p })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unsubscribe()
+
+	publishUntil(t, pub, roomID, []byte("first\nwith newline"), got)
+
+	// LISTEN 커넥션을 서버 쪽에서 끊으면 다시 연결해서 이후 알림을 계속 받아야 함
+	pool := testPool(t, randomName("broker-test-"))
+	if _, err := pool.Exec(context.Background(),
+		`SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE application_name = $1`, listenerApp); err != nil {
+		t.Fatal(err)
+	}
+	for len(got) > 0 {
+		<-got
+	}
+	publishUntil(t, pub, roomID, []byte("after reconnect"), got)
+}
@@ -0,0 +1,457 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// Config는 usersvc / chatsvc가 쓰는 전체 설정입니다.
+// 우선순위: 기본값 < 설정 파일(--config 또는 CONFIG_FILE) < 환경 변수 < 명령행 플래그
+//
+// 각 필드의 태그
+//   - key:    설정 파일 / 플래그 이름 ("섹션.키", 플래그는 --database.url 형태)
+//   - env:    환경 변수 이름
+//   - secret: "true"면 --print-config 출력에서 가림
+type Config struct {
+	Database    DatabaseConfig    `key:"database"`
+	Auth        AuthConfig        `key:"auth"`
+	Phone       PhoneConfig       `key:"phone"`
+	Social      SocialConfig      `key:"social"`
+	UserService UserServiceConfig `key:"usersvc"`
+	ChatService ChatServiceConfig `key:"chatsvc"`
+	ChatGateway ChatGatewayConfig `key:"chatgw"`
+	Admin       AdminConfig       `key:"admin"`
+	Client      ClientConfig      `key:"client"`
+	TLS         TLSConfig         `key:"tls"`
+}
+
+type DatabaseConfig struct {
+	URL               string        `key:"url" env:"DATABASE_URL" secret:"true" help:"Postgres 접속 URL"`
+	MaxConns          int32         `key:"max_conns" env:"DB_MAX_CONNS" help:"커넥션 풀 최대 크기"`
+	MinConns          int32         `key:"min_conns" env:"DB_MIN_CONNS" help:"커넥션 풀 최소 크기"`
+	HealthCheckPeriod time.Duration `key:"health_check_period" env:"DB_HEALTH_CHECK_PERIOD" help:"풀 헬스 체크 주기"`
+	ConnectTimeout    time.Duration `key:"connect_timeout" env:"DB_CONNECT_TIMEOUT" help:"시작 시 DB 연결 확인 제한 시간"`
+	AutoMigrate       bool          `key:"auto_migrate" env:"DB_AUTO_MIGRATE" help:"시작 시 아직 적용하지 않은 마이그레이션 적용"`
+}
+
+type AuthConfig struct {
+	JWTSecret           string        `key:"jwt_secret" env:"JWT_SECRET" secret:"true" help:"HS256 서명 키 (signing_keys_dir가 있으면 예전 토큰 검증에만 씀)"`
+	SigningKeysDir      string        `key:"signing_keys_dir" env:"JWT_SIGNING_KEYS_DIR" help:"RS256/EdDSA 키 디렉터리 (<kid>.pem 개인 키, <kid>.pub.pem 검증 전용 공개 키)"`
+	SigningKeyID        string        `key:"signing_key_id" env:"JWT_SIGNING_KEY_ID" help:"서명에 쓸 kid, 비우면 이름순 마지막 개인 키"`
+	JWKS                string        `key:"jwks" env:"JWT_JWKS" help:"검증용 JWKS 문서 (http(s) URL 또는 파일 경로)"`
+	JWKSRefreshInterval time.Duration `key:"jwks_refresh_interval" env:"JWT_JWKS_REFRESH_INTERVAL" help:"JWKS를 다시 받는 주기"`
+	AccessTokenTTL      time.Duration `key:"access_token_ttl" env:"ACCESS_TOKEN_TTL" help:"access token 유효 기간"`
+	RefreshTokenTTL     time.Duration `key:"refresh_token_ttl" env:"REFRESH_TOKEN_TTL" help:"refresh token 유효 기간 (쓸 때마다 새 토큰으로 교체)"`
+}
+
+type PhoneConfig struct {
+	SMSSender      string        `key:"sms_sender" env:"SMS_SENDER" help:"문자 발송 방식 (none이면 전화번호 인증을 쓰지 않음, log / file은 개발용)"`
+	SMSFile        string        `key:"sms_file" env:"SMS_FILE" help:"sms_sender=file일 때 보낸 문자를 적을 파일"`
+	DevSMS         bool          `key:"dev_sms" env:"SMS_DEV" help:"인증번호를 평문으로 남기는 개발용 발송기(log, file) 허용"`
+	CodeTTL        time.Duration `key:"code_ttl" env:"PHONE_CODE_TTL" help:"인증번호 유효 기간"`
+	MaxAttempts    int           `key:"max_attempts" env:"PHONE_MAX_ATTEMPTS" help:"인증번호 하나당 확인 시도 횟수 상한"`
+	ResendCooldown time.Duration `key:"resend_cooldown" env:"PHONE_RESEND_COOLDOWN" help:"같은 번호로 다시 요청할 수 있을 때까지 기다리는 시간"`
+}
+
+type SocialConfig struct {
+	KakaoAPIURL   string        `key:"kakao_api_url" env:"KAKAO_API_URL" help:"카카오 API 주소 (/v1/user/access_token_info, /v2/user/me)"`
+	KakaoAppID    string        `key:"kakao_app_id" env:"KAKAO_APP_ID" help:"이 서비스의 카카오 앱 ID (이 앱에 발급된 토큰만 받음, 비어 있으면 카카오 로그인을 쓰지 않음)"`
+	NaverAPIURL   string        `key:"naver_api_url" env:"NAVER_API_URL" help:"네이버 API 주소 (/v1/nid/verify, /v1/nid/me)"`
+	NaverClientID string        `key:"naver_client_id" env:"NAVER_CLIENT_ID" help:"이 서비스의 네이버 Client ID (이 앱에 발급된 토큰만 받음, 비어 있으면 네이버 로그인을 쓰지 않음)"`
+	Timeout       time.Duration `key:"timeout" env:"SOCIAL_TIMEOUT" help:"소셜 API 요청 제한 시간"`
+}
+
+type UserServiceConfig struct {
+	ListenAddr      string        `key:"listen_addr" env:"USERSVC_LISTEN_ADDR" help:"UserService gRPC 리슨 주소"`
+	JWKSAddr        string        `key:"jwks_addr" env:"USERSVC_JWKS_ADDR" help:"공개 키 문서(/.well-known/jwks.json) HTTP 주소, 비우면 끔"`
+	ShutdownTimeout time.Duration `key:"shutdown_timeout" env:"USERSVC_SHUTDOWN_TIMEOUT" help:"종료 신호 후 정리 제한 시간"`
+}
+
+type ChatServiceConfig struct {
+	ListenAddr          string        `key:"listen_addr" env:"CHATSVC_LISTEN_ADDR" help:"ChatService gRPC 리슨 주소"`
+	MetricsAddr         string        `key:"metrics_addr" env:"CHAT_METRICS_ADDR" help:"expvar(/debug/vars) HTTP 주소, 비우면 끔"`
+	Broker              string        `key:"broker" env:"CHAT_BROKER" help:"이벤트 브로커 (memory 또는 postgres)"`
+	HistoryPageSize     int           `key:"history_page_size" env:"CHAT_HISTORY_PAGE_SIZE" help:"기록 한 페이지 기본 메시지 수 (JoinChat 재전송 포함)"`
+	MaxHistoryPageSize  int           `key:"max_history_page_size" env:"CHAT_MAX_HISTORY_PAGE_SIZE" help:"GetMessages limit 상한"`
+	SendQueueSize       int           `key:"send_queue_size" env:"CHAT_SEND_QUEUE_SIZE" help:"접속자별 송신 큐 크기"`
+	AccessCheckInterval time.Duration `key:"access_check_interval" env:"CHAT_ACCESS_CHECK_INTERVAL" help:"접속 중인 스트림의 세션과 방 멤버 여부를 다시 확인하는 간격 (끊긴 세션/내보내진 멤버의 스트림을 닫음)"`
+	ShutdownTimeout     time.Duration `key:"shutdown_timeout" env:"CHATSVC_SHUTDOWN_TIMEOUT" help:"종료 신호 후 drain + 정리 제한 시간"`
+}
+
+type ChatGatewayConfig struct {
+	ListenAddr      string        `key:"listen_addr" env:"CHATGW_LISTEN_ADDR" help:"REST/WebSocket 게이트웨이 HTTP 리슨 주소"`
+	UserServiceAddr string        `key:"usersvc_addr" env:"CHATGW_USERSVC_ADDR" help:"UserService gRPC 주소"`
+	ChatServiceAddr string        `key:"chatsvc_addr" env:"CHATGW_CHATSVC_ADDR" help:"ChatService gRPC 주소"`
+	AllowedOrigins  string        `key:"allowed_origins" env:"CHATGW_ALLOWED_ORIGINS" help:"CORS/WebSocket을 허용할 Origin (쉼표로 구분, 비어 있으면 다른 Origin의 브라우저 요청은 모두 거부)"`
+	ShutdownTimeout time.Duration `key:"shutdown_timeout" env:"CHATGW_SHUTDOWN_TIMEOUT" help:"종료 신호 후 정리 제한 시간"`
+}
+
+type AdminConfig struct {
+	Token           string        `key:"token" env:"ADMIN_TOKEN" secret:"true" help:"관리자 API(AdminService) 토큰 (admin 역할로 취급), 비우면 역할이 있는 유저 토큰으로만 접속"`
+	AccessToken     string        `key:"access_token" env:"ADMIN_ACCESS_TOKEN" secret:"true" help:"관리자 CLI가 admin.token 대신 쓸 moderator/admin 유저의 access token"`
+	UserServiceAddr string        `key:"usersvc_addr" env:"ADMIN_USERSVC_ADDR" help:"관리자 CLI가 접속할 UserService gRPC 주소"`
+	Timeout         time.Duration `key:"timeout" env:"ADMIN_TIMEOUT" help:"관리자 CLI 요청 제한 시간"`
+}
+
+// ClientConfig: 터미널 채팅 클라이언트 (cmd/chat)
+type ClientConfig struct {
+	UserServiceAddr   string        `key:"usersvc_addr" env:"CHAT_USERSVC_ADDR" help:"로그인/토큰 갱신에 쓸 UserService gRPC 주소"`
+	ChatServiceAddr   string        `key:"chatsvc_addr" env:"CHAT_CHATSVC_ADDR" help:"ChatService gRPC 주소"`
+	Username          string        `key:"username" env:"CHAT_USERNAME" help:"로그인 아이디, 비우면 시작할 때 물어봄"`
+	Password          string        `key:"password" env:"CHAT_PASSWORD" secret:"true" help:"비밀번호, 비우면 시작할 때 물어봄 (스크립트로 테스트할 때만)"`
+	Timeout           time.Duration `key:"timeout" env:"CHAT_TIMEOUT" help:"스트림이 아닌 요청 하나의 제한 시간"`
+	ReconnectMaxDelay time.Duration `key:"reconnect_max_delay" env:"CHAT_RECONNECT_MAX_DELAY" help:"연결이 끊겼을 때 다시 접속하는 간격의 최대값 (1초부터 두 배씩)"`
+	PageSize          int32         `key:"page_size" env:"CHAT_PAGE_SIZE" help:"방 목록 / 이전 메시지를 한 번에 가져올 개수"`
+}
+
+// TLSConfig: gRPC 서버/클라이언트 공통 TLS 설정 (프로세스마다 자기 인증서를 넣음)
+// 서버는 cert_file/key_file로 서빙하고, 클라이언트는 ca_file로 서버 인증서를 검증하면서
+// cert_file/key_file이 있으면 클라이언트 인증서로 보냄 (mTLS, 게이트웨이 → usersvc/chatsvc)
+type TLSConfig struct {
+	Enabled    bool   `key:"enabled" env:"TLS_ENABLED" help:"gRPC 연결에 TLS 사용 (끄면 평문, 로컬 개발용)"`
+	CertFile   string `key:"cert_file" env:"TLS_CERT_FILE" help:"인증서 PEM (서버 인증서, mTLS면 클라이언트 인증서로도 씀). 파일이 바뀌면 다시 읽음"`
+	KeyFile    string `key:"key_file" env:"TLS_KEY_FILE" help:"cert_file의 개인 키 PEM"`
+	CAFile     string `key:"ca_file" env:"TLS_CA_FILE" help:"상대 인증서를 검증할 CA PEM (클라이언트는 비우면 시스템 CA)"`
+	ServerName string `key:"server_name" env:"TLS_SERVER_NAME" help:"클라이언트가 검증할 서버 이름, 비우면 접속 주소의 호스트"`
+	ClientAuth string `key:"client_auth" env:"TLS_CLIENT_AUTH" help:"서버의 클라이언트 인증서 요구 (none, optional: 보내면 검증, require: mTLS 필수)"`
+}
+
+// Default: 기본값 (시크릿은 비어 있으므로 환경 변수나 설정 파일로 채워야 함)
+func Default() *Config {
+	return &Config{
+		Database: DatabaseConfig{
+			MaxConns:          10,
+			MinConns:          2,
+			HealthCheckPeriod: time.Minute,
+			ConnectTimeout:    60 * time.Second,
+			AutoMigrate:       true,
+		},
+		Auth: AuthConfig{
+			JWKSRefreshInterval: 5 * time.Minute,
+			AccessTokenTTL:      15 * time.Minute,
+			RefreshTokenTTL:     30 * 24 * time.Hour,
+		},
+		Phone: PhoneConfig{
+			SMSSender:      "none",
+			CodeTTL:        5 * time.Minute,
+			MaxAttempts:    5,
+			ResendCooldown: time.Minute,
+		},
+		Social: SocialConfig{
+			KakaoAPIURL: "https://kapi.kakao.com",
+			NaverAPIURL: "https://openapi.naver.com",
+			Timeout:     5 * time.Second,
+		},
+		UserService: UserServiceConfig{
+			ListenAddr:      ":50051",
+			JWKSAddr:        ":8081",
+			ShutdownTimeout: 10 * time.Second,
+		},
+		ChatService: ChatServiceConfig{
+			ListenAddr:          ":50052",
+			Broker:              "memory",
+			HistoryPageSize:     50,
+			MaxHistoryPageSize:  100,
+			SendQueueSize:       256,
+			AccessCheckInterval: 30 * time.Second,
+			ShutdownTimeout:     20 * time.Second,
+		},
+		ChatGateway: ChatGatewayConfig{
+			ListenAddr:      ":8080",
+			UserServiceAddr: "localhost:50051",
+			ChatServiceAddr: "localhost:50052",
+			ShutdownTimeout: 10 * time.Second,
+		},
+		Admin: AdminConfig{
+			UserServiceAddr: "localhost:50051",
+			Timeout:         10 * time.Second,
+		},
+		Client: ClientConfig{
+			UserServiceAddr:   "localhost:50051",
+			ChatServiceAddr:   "localhost:50052",
+			Timeout:           10 * time.Second,
+			ReconnectMaxDelay: 30 * time.Second,
+			PageSize:          20,
+		},
+		TLS: TLSConfig{
+			ClientAuth: "none",
+		},
+	}
+}
+
+// Validate: 값 범위 / 필수 값 확인 (문제를 모두 모아서 돌려줌)
+// sections를 주면 그 섹션만 확인함 (DB를 쓰지 않는 게이트웨이가 database.url을 요구받지 않도록)
+func (c *Config) Validate(sections ...string) error {
+	var errs []error
+	check := func(section string, ok bool, format string, args ...any) {
+		if !ok && (len(sections) == 0 || slices.Contains(sections, section)) {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check("database", c.Database.URL != "", "database.url (DATABASE_URL) is required")
+	check("database", c.Database.MaxConns > 0, "database.max_conns must be positive")
+	check("database", c.Database.MinConns >= 0 && c.Database.MinConns <= c.Database.MaxConns, "database.min_conns must be between 0 and max_conns")
+	check("database", c.Database.HealthCheckPeriod > 0, "database.health_check_period must be positive")
+	check("database", c.Database.ConnectTimeout > 0, "database.connect_timeout must be positive")
+
+	check("auth", c.Auth.JWTSecret != "" || c.Auth.SigningKeysDir != "" || c.Auth.JWKS != "",
+		"one of auth.jwt_secret (JWT_SECRET), auth.signing_keys_dir or auth.jwks is required")
+	check("auth", c.Auth.JWKSRefreshInterval > 0, "auth.jwks_refresh_interval must be positive")
+	check("auth", c.Auth.AccessTokenTTL > 0, "auth.access_token_ttl must be positive")
+	check("auth", c.Auth.RefreshTokenTTL > c.Auth.AccessTokenTTL, "auth.refresh_token_ttl must be longer than access_token_ttl")
+
+	check("phone", slices.Contains([]string{"none", "log", "file"}, c.Phone.SMSSender), "phone.sms_sender must be none, log or file, got %q", c.Phone.SMSSender)
+	check("phone", c.Phone.SMSSender == "none" || c.Phone.DevSMS, "phone.sms_sender=%s writes verification codes in plain text; set phone.dev_sms=true to allow it (development only)", c.Phone.SMSSender)
+	check("phone", c.Phone.SMSSender != "file" || c.Phone.SMSFile != "", "phone.sms_file is required when phone.sms_sender is file")
+	check("phone", c.Phone.CodeTTL > 0, "phone.code_ttl must be positive")
+	check("phone", c.Phone.MaxAttempts > 0, "phone.max_attempts must be positive")
+	check("phone", c.Phone.ResendCooldown >= 0, "phone.resend_cooldown must not be negative")
+
+	check("social", c.Social.KakaoAPIURL != "", "social.kakao_api_url is required")
+	check("social", c.Social.NaverAPIURL != "", "social.naver_api_url is required")
+	_, appIDErr := strconv.ParseInt(c.Social.KakaoAppID, 10, 64)
+	check("social", c.Social.KakaoAppID == "" || appIDErr == nil, "social.kakao_app_id must be a number, got %q", c.Social.KakaoAppID)
+	check("social", c.Social.Timeout > 0, "social.timeout must be positive")
+
+	check("usersvc", c.UserService.ListenAddr != "", "usersvc.listen_addr is required")
+	check("usersvc", c.UserService.ShutdownTimeout > 0, "usersvc.shutdown_timeout must be positive")
+
+	check("chatsvc", c.ChatService.ListenAddr != "", "chatsvc.listen_addr is required")
+	check("chatsvc", c.ChatService.Broker == "memory" || c.ChatService.Broker == "postgres", "chatsvc.broker must be memory or postgres, got %q", c.ChatService.Broker)
+	check("chatsvc", c.ChatService.HistoryPageSize > 0, "chatsvc.history_page_size must be positive")
+	check("chatsvc", c.ChatService.MaxHistoryPageSize >= c.ChatService.HistoryPageSize, "chatsvc.max_history_page_size must be at least history_page_size")
+	check("chatsvc", c.ChatService.SendQueueSize > 0, "chatsvc.send_queue_size must be positive")
+	check("chatsvc", c.ChatService.AccessCheckInterval > 0, "chatsvc.access_check_interval must be positive")
+	check("chatsvc", c.ChatService.ShutdownTimeout > 0, "chatsvc.shutdown_timeout must be positive")
+
+	check("chatgw", c.ChatGateway.ListenAddr != "", "chatgw.listen_addr is required")
+	check("chatgw", c.ChatGateway.UserServiceAddr != "", "chatgw.usersvc_addr is required")
+	check("chatgw", c.ChatGateway.ChatServiceAddr != "", "chatgw.chatsvc_addr is required")
+	check("chatgw", c.ChatGateway.ShutdownTimeout > 0, "chatgw.shutdown_timeout must be positive")
+	check("chatgw", !strings.Contains(c.ChatGateway.AllowedOrigins, "*"), "chatgw.allowed_origins must list origins explicitly (\"*\" is not allowed because WebSocket accepts ?access_token=)")
+
+	check("admin", c.Admin.Token == "" || len(c.Admin.Token) >= 16, "admin.token must be at least 16 characters")
+	check("admin", c.Admin.UserServiceAddr != "", "admin.usersvc_addr is required")
+	check("admin", c.Admin.Timeout > 0, "admin.timeout must be positive")
+
+	check("client", c.Client.UserServiceAddr != "", "client.usersvc_addr is required")
+	check("client", c.Client.ChatServiceAddr != "", "client.chatsvc_addr is required")
+	check("client", c.Client.Timeout > 0, "client.timeout must be positive")
+	check("client", c.Client.ReconnectMaxDelay >= time.Second, "client.reconnect_max_delay must be at least 1s")
+	check("client", c.Client.PageSize > 0 && c.Client.PageSize <= 100, "client.page_size must be between 1 and 100")
+
+	check("tls", (c.TLS.CertFile == "") == (c.TLS.KeyFile == ""), "tls.cert_file and tls.key_file must be set together")
+	check("tls", slices.Contains([]string{"none", "optional", "require"}, c.TLS.ClientAuth), "tls.client_auth must be none, optional or require, got %q", c.TLS.ClientAuth)
+	check("tls", c.TLS.ClientAuth == "none" || c.TLS.CAFile != "", "tls.ca_file is required when tls.client_auth is %s", c.TLS.ClientAuth)
+
+	return errors.Join(errs...)
+}
+
+// setting: 설정 값 하나 (Config 필드를 가리킴)
+type setting struct {
+	key    string // "섹션.키"
+	env    string
+	secret bool
+	help   string
+	value  reflect.Value
+}
+
+// settings: Config의 모든 설정 값을 선언 순서대로 돌려줌
+func (c *Config) settings() []setting {
+	var out []setting
+	root := reflect.ValueOf(c).Elem()
+	for i := 0; i < root.NumField(); i++ {
+		section := root.Type().Field(i)
+		sv := root.Field(i)
+		for j := 0; j < sv.NumField(); j++ {
+			f := sv.Type().Field(j)
+			out = append(out, setting{
+				key:    section.Tag.Get("key") + "." + f.Tag.Get("key"),
+				env:    f.Tag.Get("env"),
+				secret: f.Tag.Get("secret") == "true",
+				help:   f.Tag.Get("help"),
+				value:  sv.Field(j),
+			})
+		}
+	}
+	return out
+}
+
+// set: 문자열 값을 필드 타입에 맞게 변환해서 넣음
+func (s setting) set(raw string) error {
+	switch s.value.Interface().(type) {
+	case string:
+		s.value.SetString(raw)
+	case time.Duration:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("%s: invalid duration %q", s.key, raw)
+		}
+		s.value.SetInt(int64(d))
+	case bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("%s: invalid boolean %q", s.key, raw)
+		}
+		s.value.SetBool(b)
+	case int, int32:
+		n, err := strconv.ParseInt(raw, 10, s.value.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%s: invalid integer %q", s.key, raw)
+		}
+		s.value.SetInt(n)
+	default:
+		return fmt.Errorf("%s: unsupported type %s", s.key, s.value.Type())
+	}
+	return nil
+}
+
+func (s setting) String() string {
+	if d, ok := s.value.Interface().(time.Duration); ok {
+		return d.String()
+	}
+	return fmt.Sprint(s.value.Interface())
+}
+
+// Options: Load 결과 중 설정 값이 아닌 것
+type Options struct {
+	PrintConfig bool     // --print-config
+	Args        []string // 플래그 뒤에 남은 인자 (하위 명령 등)
+}
+
+// Load: args(os.Args[1:] 형태)와 환경 변수, 설정 파일을 합쳐서 설정을 만듦 (검증은 하지 않음)
+func Load(name string, args []string) (*Config, Options, error) {
+	var opts Options
+	cfg := Default()
+	settings := cfg.settings()
+
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	configFile := fs.String("config", os.Getenv("CONFIG_FILE"), "설정 파일 경로 (YAML, 확장자가 .toml이면 TOML)")
+	fs.BoolVar(&opts.PrintConfig, "print-config", false, "적용된 설정을 출력하고 종료 (시크릿은 가림)")
+	flagValues := make(map[string]*string, len(settings))
+	for _, s := range settings {
+		help := s.help
+		if s.env != "" {
+			help += " (env " + s.env + ")"
+		}
+		flagValues[s.key] = fs.String(s.key, "", help)
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, opts, err
+	}
+	opts.Args = fs.Args()
+
+	// 1. 설정 파일
+	if *configFile != "" {
+		values, err := readFile(*configFile)
+		if err != nil {
+			return nil, opts, err
+		}
+		for _, s := range settings {
+			if raw, ok := values[s.key]; ok {
+				if err := s.set(raw); err != nil {
+					return nil, opts, fmt.Errorf("%s: %w", *configFile, err)
+				}
+				delete(values, s.key)
+			}
+		}
+		for key := range values {
+			return nil, opts, fmt.Errorf("%s: unknown setting %q", *configFile, key)
+		}
+	}
+
+	// 2. 환경 변수
+	for _, s := range settings {
+		if raw := os.Getenv(s.env); s.env != "" && raw != "" {
+			if err := s.set(raw); err != nil {
+				return nil, opts, fmt.Errorf("env %s: %w", s.env, err)
+			}
+		}
+	}
+
+	// 3. 플래그 (명시한 것만)
+	var flagErr error
+	fs.Visit(func(f *flag.Flag) {
+		for _, s := range settings {
+			if s.key == f.Name && flagErr == nil {
+				flagErr = s.set(*flagValues[s.key])
+			}
+		}
+	})
+	if flagErr != nil {
+		return nil, opts, flagErr
+	}
+
+	return cfg, opts, nil
+}
+
+// MustLoad: .env와 os.Args로 설정을 읽고 검증함 (sections를 주면 그 섹션만 검증, Validate 참고)
+// --print-config면 설정을 출력하고 종료, 설정이 잘못됐으면 에러를 출력하고 종료
+func MustLoad(sections ...string) *Config {
+	cfg, _ := MustLoadArgs(sections...)
+	return cfg
+}
+
+// MustLoadArgs: MustLoad와 같지만 플래그 뒤에 남은 인자도 돌려줌 (하위 명령이 있는 도구용)
+func MustLoadArgs(sections ...string) (*Config, []string) {
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found (this is ok in production)")
+	}
+
+	cfg, opts, err := Load(os.Args[0], os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	verr := cfg.Validate(sections...)
+	if opts.PrintConfig {
+		cfg.Write(os.Stdout, true)
+		if verr != nil {
+			fmt.Fprintf(os.Stderr, "invalid config:\n%v\n", verr)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	if verr != nil {
+		log.Fatalf("Invalid config:\n%v", verr)
+	}
+	return cfg, opts.Args
+}
+
+// Write: 설정을 설정 파일과 같은 YAML 형식으로 출력 (redact면 시크릿은 가림)
+func (c *Config) Write(w io.Writer, redact bool) {
+	section := ""
+	for _, s := range c.settings() {
+		sec, key, _ := strings.Cut(s.key, ".")
+		if sec != section {
+			if section != "" {
+				fmt.Fprintln(w)
+			}
+			fmt.Fprintf(w, "%s:\n", sec)
+			section = sec
+		}
+		value := s.String()
+		if redact && s.secret && value != "" {
+			value = "[REDACTED]"
+		}
+		if s.value.Kind() == reflect.String {
+			value = strconv.Quote(value)
+		}
+		fmt.Fprintf(w, "  %s: %s\n", key, value)
+	}
+}
@@ -0,0 +1,221 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+	return writeNamedFile(t, "config.yaml", content)
+}
+
+func writeNamedFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := writeFile(t, `
+# 파일 < 환경 변수 < 플래그
+database:
+  url: "postgres://file"  # 주석
+  max_conns: 20
+chatsvc:
+  broker: postgres
+  history_page_size: 40
+  send_queue_size: 64
+`)
+	t.Setenv("CONFIG_FILE", "")
+	t.Setenv("DATABASE_URL", "")
+	t.Setenv("CHAT_HISTORY_PAGE_SIZE", "30")
+	t.Setenv("CHAT_SEND_QUEUE_SIZE", "32")
+
+	cfg, opts, err := Load("test", []string{"--config", path, "--chatsvc.send_queue_size=16", "--auth.access_token_ttl=1h"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.PrintConfig {
+		t.Error("PrintConfig set without --print-config")
+	}
+
+	if cfg.Database.URL != "postgres://file" || cfg.Database.MaxConns != 20 {
+		t.Errorf("file values not applied: %+v", cfg.Database)
+	}
+	if cfg.Database.MinConns != 2 {
+		t.Errorf("MinConns = %d, want default 2", cfg.Database.MinConns)
+	}
+	if cfg.ChatService.HistoryPageSize != 30 {
+		t.Errorf("HistoryPageSize = %d, want env value 30", cfg.ChatService.HistoryPageSize)
+	}
+	if cfg.ChatService.SendQueueSize != 16 {
+		t.Errorf("SendQueueSize = %d, want flag value 16", cfg.ChatService.SendQueueSize)
+	}
+	if cfg.Auth.AccessTokenTTL != time.Hour {
+		t.Errorf("AccessTokenTTL = %s, want 1h", cfg.Auth.AccessTokenTTL)
+	}
+}
+
+func TestLoadTOMLFile(t *testing.T) {
+	path := writeNamedFile(t, "config.toml", `
+# 확장자가 .toml이면 TOML로 읽음
+[database]
+url = "postgres://file#not-a-comment"  # 주석
+max_conns = 20
+
+[chatsvc]
+broker = 'postgres'
+`)
+	t.Setenv("CONFIG_FILE", "")
+	t.Setenv("DATABASE_URL", "")
+	t.Setenv("CHAT_BROKER", "")
+
+	cfg, _, err := Load("test", []string{"--config", path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Database.URL != "postgres://file#not-a-comment" || cfg.Database.MaxConns != 20 || cfg.ChatService.Broker != "postgres" {
+		t.Fatalf("TOML values not applied: %+v %+v", cfg.Database, cfg.ChatService)
+	}
+
+	// YAML 문법이나 지원하지 않는 TOML 문법은 줄 번호와 함께 거부
+	for content, want := range map[string]string{
+		"database:\n  url: x\n":       `config.toml:1: expected "key = value"`,
+		"max_conns = 1\n":             `config.toml:1: "max_conns" is not inside a section`,
+		"[[database]]\nurl = \"x\"\n": "config.toml:1: invalid section header",
+		"[database]\nurl = 'it''s'\n": "config.toml:2: invalid literal string",
+	} {
+		if _, _, err := Load("test", []string{"--config", writeNamedFile(t, "config.toml", content)}); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Load(%q) err = %v, want %q", content, err, want)
+		}
+	}
+}
+
+func TestLoadYAMLQuotingAndIndentation(t *testing.T) {
+	t.Setenv("CONFIG_FILE", "")
+	t.Setenv("DATABASE_URL", "")
+
+	// 작은따옴표 안의 작은따옴표는 두 번 써서 표현
+	cfg, _, err := Load("test", []string{"--config", writeFile(t, "database:\n  url: 'postgres://it''s'\n")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Database.URL != "postgres://it's" {
+		t.Fatalf("URL = %q, want postgres://it's", cfg.Database.URL)
+	}
+
+	for content, want := range map[string]string{
+		"database:\n\turl: x\n":         "config.yaml:2: tabs are not allowed in YAML indentation",
+		"database:\n  \turl: x\n":       "config.yaml:2: tabs are not allowed in YAML indentation",
+		"database:\n  url: 'it's'\n":    "config.yaml:2: invalid string 'it's'",
+		"database:\n  url: 'postgres\n": "config.yaml:2: unterminated string",
+	} {
+		if _, _, err := Load("test", []string{"--config", writeFile(t, content)}); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Load(%q) err = %v, want %q", content, err, want)
+		}
+	}
+}
+
+func TestLoadRejectsUnknownFileKey(t *testing.T) {
+	path := writeFile(t, "chatsvc:\n  brokr: memory\n")
+	t.Setenv("CONFIG_FILE", "")
+
+	if _, _, err := Load("test", []string{"--config", path}); err == nil || !strings.Contains(err.Error(), "chatsvc.brokr") {
+		t.Fatalf("err = %v, want unknown setting error", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cfg := Default()
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "database.url") || !strings.Contains(err.Error(), "auth.jwt_secret") {
+		t.Fatalf("Validate() = %v, want missing secret errors", err)
+	}
+
+	cfg.Database.URL = "postgres://x"
+	cfg.Auth.JWTSecret = "secret"
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() = %v, want nil for defaults plus secrets", err)
+	}
+
+	cfg.ChatService.Broker = "redis"
+	cfg.ChatService.MaxHistoryPageSize = 10
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "chatsvc.broker") || !strings.Contains(err.Error(), "max_history_page_size") {
+		t.Fatalf("Validate() = %v, want broker and page size errors", err)
+	}
+
+	// 섹션을 고르면 그 섹션만 확인 (게이트웨이는 DB/JWT 설정이 없어도 됨)
+	cfg = Default()
+	if err := cfg.Validate("chatgw"); err != nil {
+		t.Fatalf(`Validate("chatgw") = %v, want nil without secrets`, err)
+	}
+	cfg.ChatGateway.ChatServiceAddr = ""
+	if err := cfg.Validate("chatgw"); err == nil || !strings.Contains(err.Error(), "chatgw.chatsvc_addr") || strings.Contains(err.Error(), "database.url") {
+		t.Fatalf(`Validate("chatgw") = %v, want only the chatgw error`, err)
+	}
+
+	// 게이트웨이는 ?access_token=을 받으므로 모든 Origin("*") 허용은 거부
+	cfg.ChatGateway.ChatServiceAddr = Default().ChatGateway.ChatServiceAddr
+	cfg.ChatGateway.AllowedOrigins = "https://chat.example.com, *"
+	if err := cfg.Validate("chatgw"); err == nil || !strings.Contains(err.Error(), "chatgw.allowed_origins") {
+		t.Fatalf(`Validate("chatgw") = %v, want allowed_origins error for "*"`, err)
+	}
+
+	// 인증번호를 평문으로 남기는 발송기는 dev_sms가 있어야 함
+	cfg = Default()
+	cfg.Phone.SMSSender = "log"
+	if err := cfg.Validate("phone"); err == nil || !strings.Contains(err.Error(), "phone.dev_sms") {
+		t.Fatalf(`Validate("phone") = %v, want dev_sms error for sms_sender=log`, err)
+	}
+	cfg.Phone.DevSMS = true
+	if err := cfg.Validate("phone"); err != nil {
+		t.Fatalf(`Validate("phone") = %v, want nil with dev_sms`, err)
+	}
+
+	// mTLS는 클라이언트 인증서를 검증할 CA가 있어야 함
+	cfg = Default()
+	cfg.TLS.CertFile = "server.pem"
+	cfg.TLS.ClientAuth = "require"
+	if err := cfg.Validate("tls"); err == nil || !strings.Contains(err.Error(), "tls.key_file") || !strings.Contains(err.Error(), "tls.ca_file") {
+		t.Fatalf(`Validate("tls") = %v, want key_file and ca_file errors`, err)
+	}
+}
+
+func TestWriteRedactsSecrets(t *testing.T) {
+	cfg := Default()
+	cfg.Database.URL = "postgres://user:pw@host/db"
+	cfg.Auth.JWTSecret = "super-secret"
+
+	var buf bytes.Buffer
+	cfg.Write(&buf, true)
+	out := buf.String()
+	if strings.Contains(out, "pw@host") || strings.Contains(out, "super-secret") {
+		t.Fatalf("secrets leaked:\n%s", out)
+	}
+	if !strings.Contains(out, `listen_addr: ":50052"`) {
+		t.Fatalf("missing non-secret values:\n%s", out)
+	}
+
+	// 출력은 그대로 설정 파일로 다시 읽을 수 있어야 함
+	buf.Reset()
+	cfg.Write(&buf, false)
+	t.Setenv("CONFIG_FILE", "")
+	t.Setenv("DATABASE_URL", "")
+	t.Setenv("JWT_SECRET", "")
+	reloaded, _, err := Load("test", []string{"--config", writeFile(t, buf.String())})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *reloaded != *cfg {
+		t.Fatalf("round trip mismatch:\n got %+v\nwant %+v", reloaded, cfg)
+	}
+}
@@ -0,0 +1,164 @@
+package config
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// readFile: 설정 파일을 "섹션.키" → 값 으로 읽음
+// 확장자가 .toml이면 TOML, 그 밖에는 YAML로 읽습니다. 두 형식 모두 한 단계짜리 섹션과 # 주석, 따옴표 문자열만 지원합니다.
+//
+//	# YAML                          # TOML
+//	database:                       [database]
+//	  url: "postgres://..."         url = "postgres://..."
+//	  max_conns: 10   # 주석        max_conns = 10   # 주석
+func readFile(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open config file: %w", err)
+	}
+	defer f.Close()
+
+	isTOML := strings.EqualFold(filepath.Ext(path), ".toml")
+	parseLine := parseYAMLLine
+	if isTOML {
+		parseLine = parseTOMLLine
+	}
+
+	values := make(map[string]string)
+	section := ""
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := stripComment(scanner.Text())
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		key, value, err := parseLine(line, &section)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", path, lineNo, err)
+		}
+		if key == "" {
+			continue // 섹션 시작
+		}
+		if value[0] == '"' || value[0] == '\'' {
+			unquoted, err := unquote(value, isTOML)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: %v", path, lineNo, err)
+			}
+			value = unquoted
+		}
+		values[section+"."+key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	return values, nil
+}
+
+// parseYAMLLine: 들여쓰지 않은 "섹션:"이면 section을 바꾸고 빈 key를, 들여쓴 "키: 값"이면 key와 값을 돌려줌
+// YAML은 들여쓰기에 탭을 허용하지 않으므로 공백으로만 들여써야 함
+func parseYAMLLine(line string, section *string) (string, string, error) {
+	indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+	if strings.Contains(indent, "\t") {
+		return "", "", errors.New("tabs are not allowed in YAML indentation")
+	}
+	indented := indent != ""
+	key, value, ok := strings.Cut(strings.TrimSpace(line), ":")
+	if !ok {
+		return "", "", errors.New(`expected "key: value"`)
+	}
+	key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+
+	if !indented {
+		if value != "" {
+			return "", "", fmt.Errorf("top-level %q must be a section", key)
+		}
+		*section = key
+		return "", "", nil
+	}
+	return sectionValue(*section, key, value)
+}
+
+// parseTOMLLine: "[섹션]"이면 section을 바꾸고 빈 key를, "키 = 값"이면 key와 값을 돌려줌
+func parseTOMLLine(line string, section *string) (string, string, error) {
+	line = strings.TrimSpace(line)
+	if strings.HasPrefix(line, "[") {
+		name, ok := strings.CutSuffix(line, "]")
+		name = strings.TrimSpace(name[1:])
+		if !ok || name == "" || strings.ContainsAny(name, "[]") {
+			return "", "", fmt.Errorf("invalid section header %s (only [section] is supported)", line)
+		}
+		*section = name
+		return "", "", nil
+	}
+
+	key, value, ok := strings.Cut(line, "=")
+	if !ok {
+		return "", "", errors.New(`expected "key = value"`)
+	}
+	return sectionValue(*section, strings.TrimSpace(key), strings.TrimSpace(value))
+}
+
+// sectionValue: 섹션 안의 "키/값" 한 줄을 확인
+func sectionValue(section, key, value string) (string, string, error) {
+	if section == "" {
+		return "", "", fmt.Errorf("%q is not inside a section", key)
+	}
+	if value == "" {
+		return "", "", fmt.Errorf("%q has no value", key)
+	}
+	return key, value, nil
+}
+
+// stripComment: 따옴표 밖의 # 뒤를 잘라냄
+func stripComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// unquote: 따옴표 문자열의 값
+// 작은따옴표 문자열은 YAML이면 작은따옴표 두 개를 하나로 읽고, TOML(literal string)이면 안에 작은따옴표가 올 수 없음
+func unquote(s string, isTOML bool) (string, error) {
+	if s[0] == '\'' {
+		if len(s) < 2 || s[len(s)-1] != '\'' {
+			return "", fmt.Errorf("unterminated string %s", s)
+		}
+		inner := s[1 : len(s)-1]
+		if isTOML {
+			if strings.Contains(inner, "'") {
+				return "", fmt.Errorf("invalid literal string %s (TOML literal strings cannot contain ')", s)
+			}
+			return inner, nil
+		}
+		if strings.Contains(strings.ReplaceAll(inner, "''", ""), "'") {
+			return "", fmt.Errorf("invalid string %s (write ' as '' inside single quotes)", s)
+		}
+		return strings.ReplaceAll(inner, "''", "'"), nil
+	}
+	v, err := strconv.Unquote(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid string %s", s)
+	}
+	return v, nil
+}
@@ -4,45 +4,37 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
-	"time"
 
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/joho/godotenv"
 )
 
 var Pool *pgxpool.Pool
 
-func Init() {
-	// .env 로드
-	err := godotenv.Load()
-	if err != nil {
-		log.Println("No .env file found (this is ok in production)")
-	}
-
-	dbURL := os.Getenv("DATABASE_URL")
-	if dbURL == "" {
+// Init: 설정으로 커넥션 풀을 만들고 (auto_migrate면) 마이그레이션까지 적용합니다. (.env는 config.MustLoad에서 읽음)
+func Init(cfg config.DatabaseConfig) {
+	if cfg.URL == "" {
 		log.Fatal("DATABASE_URL is not set")
 	}
 
 	// 커넥션 풀 설정
-	config, err := pgxpool.ParseConfig(dbURL)
+	poolConfig, err := pgxpool.ParseConfig(cfg.URL)
 	if err != nil {
 		log.Fatalf("Unable to parse DB config: %v", err)
 	}
 
-	config.MaxConns = 10
-	config.MinConns = 2
-	config.HealthCheckPeriod = time.Minute
+	poolConfig.MaxConns = cfg.MaxConns
+	poolConfig.MinConns = cfg.MinConns
+	poolConfig.HealthCheckPeriod = cfg.HealthCheckPeriod
 
 	// 풀 생성
-	Pool, err = pgxpool.NewWithConfig(context.Background(), config)
+	Pool, err = pgxpool.NewWithConfig(context.Background(), poolConfig)
 	if err != nil {
 		log.Fatalf("Unable to create connection pool: %v", err)
 	}
 
 	// 테스트 연결
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ConnectTimeout)
 	defer cancel()
 
 	err = Pool.Ping(ctx)
@@ -52,8 +44,10 @@ func Init() {
 
 	fmt.Println("✅ Connected to Supabase PostgreSQL!")
 
-	// ⭐️ 자동 스키마 마이그레이션 적용 (추가된 로직) ⭐️
-	// internal/db/postgres.go에 정의된 ApplyMigrations 함수를 호출합니다.
+	// 자동 스키마 마이그레이션 (끄면 cli migrate up으로 직접 적용)
+	if !cfg.AutoMigrate {
+		return
+	}
 	if err := ApplyMigrations(context.Background(), Pool); err != nil {
 		log.Fatalf("Failed to apply DB migrations: %v", err)
 	}
@@ -0,0 +1,303 @@
+package db
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// 마이그레이션 파일: migrations/NNNN_이름.up.sql / NNNN_이름.down.sql
+// 한 번 배포된 파일은 고치지 말고 새 번호로 추가해야 합니다.
+// 되돌릴 수 없는 마이그레이션은 down 파일에 SQL 없이 이유만 주석으로 적습니다. (MigrateDown이 거부함)
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationLockKey: 여러 레플리카가 동시에 마이그레이션하지 않도록 잡는 advisory lock 키 (임의의 고정값)
+const migrationLockKey int64 = 0x67525043_6d696772
+
+const schemaMigrationsTable = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+    version BIGINT PRIMARY KEY,
+    name TEXT NOT NULL,
+    applied_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+`
+
+// Migration: 번호가 붙은 스키마 변경 하나 (Up으로 적용, Down으로 되돌림)
+type Migration struct {
+	Version      int64
+	Name         string
+	Up           string
+	Down         string
+	Irreversible bool // down 파일에 주석만 있음
+}
+
+// MigrationState: 마이그레이션과 적용 여부
+// Unknown이면 DB에는 적용돼 있지만 이 바이너리에는 없는 마이그레이션 (더 새 버전이 적용한 것)
+type MigrationState struct {
+	Migration
+	AppliedAt *time.Time
+	Unknown   bool
+}
+
+// Migrations: 내장된 마이그레이션을 버전 순서대로 돌려줌
+func Migrations() ([]Migration, error) {
+	return loadMigrations(migrationFiles)
+}
+
+func loadMigrations(fsys fs.FS) ([]Migration, error) {
+	names, err := fs.Glob(fsys, "migrations/*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, path := range names {
+		file := strings.TrimPrefix(path, "migrations/")
+		base, direction, ok := strings.Cut(strings.TrimSuffix(file, ".sql"), ".")
+		if !ok || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("migration %s: file name must end with .up.sql or .down.sql", file)
+		}
+		num, name, ok := strings.Cut(base, "_")
+		version, err := strconv.ParseInt(num, 10, 64)
+		if !ok || err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration %s: file name must start with a positive version number", file)
+		}
+
+		body, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		}
+		if m.Name != name {
+			return nil, fmt.Errorf("migration %d: name mismatch %q / %q", version, m.Name, name)
+		}
+		if direction == "up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	out := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s: both up and down files are required", m.Version, m.Name)
+		}
+		m.Irreversible = !hasStatements(m.Down)
+		out = append(out, *m)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Version < out[j].Version })
+	return out, nil
+}
+
+// hasStatements: 빈 줄과 -- 주석 말고 실행할 SQL이 있는지
+func hasStatements(sql string) bool {
+	for line := range strings.Lines(sql) {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			return true
+		}
+	}
+	return false
+}
+
+// withMigrationLock: 전용 커넥션에서 advisory lock을 잡고 schema_migrations를 준비한 뒤 fn을 실행
+// 다른 레플리카가 마이그레이션 중이면 끝날 때까지 기다림
+func withMigrationLock(ctx context.Context, pool *pgxpool.Pool, fn func(conn *pgx.Conn) error) error {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", migrationLockKey); err != nil {
+		return fmt.Errorf("failed to take migration lock: %w", err)
+	}
+	defer func() {
+		// 세션 단위 lock이라 해제에 실패하면 커넥션을 닫아서 lock도 같이 풀리게 함
+		if _, err := conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockKey); err != nil {
+			conn.Conn().Close(context.Background())
+		}
+	}()
+
+	if _, err := conn.Exec(ctx, schemaMigrationsTable); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return fn(conn.Conn())
+}
+
+// appliedMigrations: schema_migrations에 기록된 버전 → (이름, 적용 시각)
+func appliedMigrations(ctx context.Context, conn *pgx.Conn) (map[int64]MigrationState, error) {
+	rows, err := conn.Query(ctx, "SELECT version, name, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int64]MigrationState)
+	for rows.Next() {
+		var st MigrationState
+		var at time.Time
+		if err := rows.Scan(&st.Version, &st.Name, &at); err != nil {
+			return nil, err
+		}
+		st.AppliedAt = &at
+		applied[st.Version] = st
+	}
+	return applied, rows.Err()
+}
+
+// runMigration: SQL 실행과 schema_migrations 기록을 한 트랜잭션으로 처리 (실패하면 둘 다 취소)
+func runMigration(ctx context.Context, conn *pgx.Conn, m Migration, up bool) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if up {
+		if _, err := tx.Exec(ctx, m.Up); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, "INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", m.Version, m.Name); err != nil {
+			return err
+		}
+	} else {
+		if _, err := tx.Exec(ctx, m.Down); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, "DELETE FROM schema_migrations WHERE version = $1", m.Version); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// MigrateUp: 아직 적용하지 않은 마이그레이션을 버전 순서대로 모두 적용하고, 적용한 것을 돌려줌
+func MigrateUp(ctx context.Context, pool *pgxpool.Pool) ([]Migration, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	err = withMigrationLock(ctx, pool, func(conn *pgx.Conn) error {
+		applied, err := appliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, m := range migrations {
+			if _, ok := applied[m.Version]; ok {
+				continue
+			}
+			if err := runMigration(ctx, conn, m, true); err != nil {
+				return fmt.Errorf("migration %d_%s up failed: %w", m.Version, m.Name, err)
+			}
+			done = append(done, m)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// MigrateDown: 적용된 마이그레이션 중 최근 것부터 steps개를 되돌리고, 되돌린 것을 돌려줌
+func MigrateDown(ctx context.Context, pool *pgxpool.Pool, steps int) ([]Migration, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	err = withMigrationLock(ctx, pool, func(conn *pgx.Conn) error {
+		applied, err := appliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+		plan, err := downPlan(migrations, applied, steps)
+		if err != nil {
+			return err
+		}
+		for _, m := range plan {
+			if err := runMigration(ctx, conn, m, false); err != nil {
+				return fmt.Errorf("migration %d_%s down failed: %w", m.Version, m.Name, err)
+			}
+			done = append(done, m)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// downPlan: 적용된 마이그레이션 중 최근 것부터 steps개 (되돌릴 수 없는 것이 끼어 있으면 하나도 되돌리지 않고 에러)
+func downPlan(migrations []Migration, applied map[int64]MigrationState, steps int) ([]Migration, error) {
+	var plan []Migration
+	for i := len(migrations) - 1; i >= 0 && len(plan) < steps; i-- {
+		m := migrations[i]
+		if _, ok := applied[m.Version]; !ok {
+			continue
+		}
+		if m.Irreversible {
+			return nil, fmt.Errorf("migration %d_%s is irreversible and cannot be reverted", m.Version, m.Name)
+		}
+		plan = append(plan, m)
+	}
+	return plan, nil
+}
+
+// MigrationStatus: 모든 마이그레이션의 적용 여부를 버전 순서대로 돌려줌
+// 읽기만 하므로 lock을 잡지 않음 (다른 레플리카가 마이그레이션 중이어도 기다리지 않고, 그 시점에 commit된 상태를 보여줌)
+func MigrationStatus(ctx context.Context, pool *pgxpool.Pool) ([]MigrationState, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	// 한 번도 마이그레이션하지 않은 DB에는 schema_migrations가 없음 (상태 조회에서는 만들지 않음)
+	var exists bool
+	if err := conn.QueryRow(ctx, "SELECT to_regclass('schema_migrations') IS NOT NULL").Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to check schema_migrations: %w", err)
+	}
+	applied := make(map[int64]MigrationState)
+	if exists {
+		if applied, err = appliedMigrations(ctx, conn.Conn()); err != nil {
+			return nil, err
+		}
+	}
+
+	var out []MigrationState
+	for _, m := range migrations {
+		st := MigrationState{Migration: m}
+		if a, ok := applied[m.Version]; ok {
+			st.AppliedAt = a.AppliedAt
+			delete(applied, m.Version)
+		}
+		out = append(out, st)
+	}
+	for _, a := range applied {
+		a.Unknown = true
+		out = append(out, a)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Version < out[j].Version })
+	return out, nil
+}
@@ -0,0 +1,242 @@
+package db
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := Migrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("no embedded migrations")
+	}
+	for i, m := range migrations {
+		if m.Version != int64(i+1) {
+			t.Errorf("migration %d_%s: versions must be contiguous from 1", m.Version, m.Name)
+		}
+	}
+	if !strings.Contains(migrations[0].Up, "CREATE TABLE IF NOT EXISTS users") {
+		t.Errorf("first migration must create the users table")
+	}
+}
+
+func TestLoadMigrationsRejectsBadFiles(t *testing.T) {
+	tests := map[string]fstest.MapFS{
+		"missing down": {
+			"migrations/0001_a.up.sql": {Data: []byte("SELECT 1;")},
+		},
+		"bad direction": {
+			"migrations/0001_a.up.sql":       {Data: []byte("SELECT 1;")},
+			"migrations/0001_a.sideways.sql": {Data: []byte("SELECT 1;")},
+		},
+		"no version": {
+			"migrations/a.up.sql":   {Data: []byte("SELECT 1;")},
+			"migrations/a.down.sql": {Data: []byte("SELECT 1;")},
+		},
+		"name mismatch": {
+			"migrations/0001_a.up.sql":   {Data: []byte("SELECT 1;")},
+			"migrations/0001_b.down.sql": {Data: []byte("SELECT 1;")},
+		},
+	}
+	for name, fsys := range tests {
+		if _, err := loadMigrations(fsys); err == nil {
+			t.Errorf("%s: loadMigrations succeeded, want error", name)
+		}
+	}
+}
+
+func TestDownPlanRefusesIrreversibleMigrations(t *testing.T) {
+	migrations, err := loadMigrations(fstest.MapFS{
+		"migrations/0001_a.up.sql":   {Data: []byte("CREATE TABLE a ();")},
+		"migrations/0001_a.down.sql": {Data: []byte("DROP TABLE a;")},
+		"migrations/0002_b.up.sql":   {Data: []byte("UPDATE a SET x = 1;")},
+		"migrations/0002_b.down.sql": {Data: []byte("-- 데이터 보정이라 되돌릴 수 없음\n\n")},
+		"migrations/0003_c.up.sql":   {Data: []byte("CREATE TABLE c ();")},
+		"migrations/0003_c.down.sql": {Data: []byte("-- 주석 뒤에 SQL이 있으면 되돌릴 수 있음\nDROP TABLE c;")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if migrations[0].Irreversible || !migrations[1].Irreversible || migrations[2].Irreversible {
+		t.Fatalf("Irreversible flags = %v %v %v, want only 0002", migrations[0].Irreversible, migrations[1].Irreversible, migrations[2].Irreversible)
+	}
+
+	applied := map[int64]MigrationState{1: {}, 2: {}, 3: {}}
+	plan, err := downPlan(migrations, applied, 1)
+	if err != nil || len(plan) != 1 || plan[0].Version != 3 {
+		t.Fatalf("downPlan(1) = %v, %v; want only 0003", plan, err)
+	}
+	// 되돌릴 수 없는 단계가 끼어 있으면 앞의 단계도 실행하지 않음
+	if plan, err := downPlan(migrations, applied, 2); err == nil || !strings.Contains(err.Error(), "2_b") || plan != nil {
+		t.Fatalf("downPlan(2) = %v, %v; want irreversible error for 2_b", plan, err)
+	}
+}
+
+func TestEmbeddedDataFixIsIrreversible(t *testing.T) {
+	migrations, err := Migrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range migrations {
+		if want := m.Name == "message_sender_ids"; m.Irreversible != want {
+			t.Errorf("migration %d_%s: Irreversible = %v, want %v", m.Version, m.Name, m.Irreversible, want)
+		}
+	}
+}
+
+// testSchemaConn: DATABASE_URL의 DB에 빈 스키마를 만들고 그 스키마만 보는 커넥션 (없으면 테스트 건너뜀)
+func testSchemaConn(t *testing.T) *pgx.Conn {
+	t.Helper()
+	url := os.Getenv("DATABASE_URL")
+	if url == "" {
+		t.Skip("DATABASE_URL is not set")
+	}
+	ctx := context.Background()
+	admin, err := pgx.Connect(ctx, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	suffix := make([]byte, 6)
+	rand.Read(suffix)
+	schema := "migrate_test_" + hex.EncodeToString(suffix)
+	if _, err := admin.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		admin.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+		admin.Close(context.Background())
+	})
+
+	cfg, err := pgx.ParseConfig(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.RuntimeParams["search_path"] = schema
+	conn, err := pgx.ConnectConfig(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close(context.Background()) })
+	if _, err := conn.Exec(ctx, schemaMigrationsTable); err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+// migrateTo: version까지의 마이그레이션을 순서대로 적용 (첫 에러를 돌려줌)
+func migrateTo(ctx context.Context, conn *pgx.Conn, version int64) error {
+	migrations, err := Migrations()
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if m.Version > version {
+			break
+		}
+		if err := runMigration(ctx, conn, m, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 예전 6글자 방 ID가 겹친 두 쌍의 메시지는 각 쌍의 방으로 나뉘어야 하고,
+// 어느 쌍의 대화인지 정할 수 없는 메시지가 있으면 아무것도 바꾸지 않고 실패해야 함
+func TestRoomUUIDMigrationSplitsCollidingLegacyRooms(t *testing.T) {
+	// alice+bob과 alice+carol은 둘 다 예전 방 ID가 "aaabbb" (bob과 carol의 UUID 앞 3글자가 같음)
+	const seed = `
+        INSERT INTO users (id, username, name, email, password_hash, created_at) VALUES
+            ('aaa00000-0000-4000-8000-000000000001', 'alice', 'alice', 'alice@example.test', 'x', '2024-01-01'),
+            ('bbb00000-0000-4000-8000-000000000002', 'bob', 'bob', 'bob@example.test', 'x', '2024-01-02'),
+            ('bbb11111-0000-4000-8000-000000000003', 'carol', 'carol', 'carol@example.test', 'x', '2024-01-03');
+        -- 먼저 만든 alice+bob의 방만 있고 alice+carol의 메시지도 같은 방에 저장됨
+        INSERT INTO rooms (room_id, user1_id, user2_id) VALUES ('aaabbb', 'bob', 'alice');
+        INSERT INTO messages (room_id, sender_id, username, message_content, sent_at) VALUES
+            ('aaabbb', 'TEMP_USER_alice', 'alice', 'alice to bob', '2024-01-02 12:00'),
+            ('aaabbb', 'TEMP_USER_bob', 'bob', 'bob to alice', '2024-01-04'),
+            ('aaabbb', 'TEMP_USER_carol', 'carol', 'carol to alice', '2024-01-04');
+    `
+	ctx := context.Background()
+
+	t.Run("split", func(t *testing.T) {
+		conn := testSchemaConn(t)
+		if err := migrateTo(ctx, conn, 2); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := conn.Exec(ctx, seed); err != nil {
+			t.Fatal(err)
+		}
+		if err := migrateTo(ctx, conn, 3); err != nil {
+			t.Fatalf("migrate 0003: %v", err)
+		}
+
+		rows, err := conn.Query(ctx, `
+            SELECT m.message_content, r.room_id, r.user1_id || '+' || r.user2_id
+            FROM messages m JOIN rooms r ON r.room_id = m.room_id`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := map[string]string{}
+		rooms := map[string]string{}
+		for rows.Next() {
+			var content, roomID, pair string
+			if err := rows.Scan(&content, &roomID, &pair); err != nil {
+				t.Fatal(err)
+			}
+			got[content] = pair
+			rooms[content] = roomID
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+
+		// alice의 첫 메시지는 carol이 가입하기 전이라 bob과의 대화
+		want := map[string]string{
+			"alice to bob":   "alice+bob",
+			"bob to alice":   "alice+bob",
+			"carol to alice": "alice+carol",
+		}
+		for content, pair := range want {
+			if got[content] != pair {
+				t.Errorf("%q is in room %q, want %q", content, got[content], pair)
+			}
+			if len(rooms[content]) != 36 {
+				t.Errorf("%q is in room %q, want a UUID room ID", content, rooms[content])
+			}
+		}
+	})
+
+	t.Run("ambiguous", func(t *testing.T) {
+		conn := testSchemaConn(t)
+		if err := migrateTo(ctx, conn, 2); err != nil {
+			t.Fatal(err)
+		}
+		// carol이 가입한 뒤 alice가 보낸 메시지는 bob과 carol 중 누구에게 보낸 것인지 알 수 없음
+		if _, err := conn.Exec(ctx, seed+`
+            INSERT INTO messages (room_id, sender_id, username, message_content, sent_at)
+            VALUES ('aaabbb', 'TEMP_USER_alice', 'alice', 'alice to ?', '2024-01-05');`); err != nil {
+			t.Fatal(err)
+		}
+		if err := migrateTo(ctx, conn, 3); err == nil || !strings.Contains(err.Error(), "aaabbb") {
+			t.Fatalf("migrate 0003 = %v, want an error naming the ambiguous room", err)
+		}
+
+		var legacy int
+		if err := conn.QueryRow(ctx, `SELECT COUNT(*) FROM messages WHERE room_id = 'aaabbb'`).Scan(&legacy); err != nil {
+			t.Fatal(err)
+		}
+		if legacy != 4 {
+			t.Fatalf("%d messages left in the legacy room, want all 4 untouched after the failed migration", legacy)
+		}
+	})
+}
@@ -0,0 +1,30 @@
+-- 유저 테이블 (service.SignUp / Login / 프로필 API가 사용)
+-- username / email의 UNIQUE 제약 이름(users_username_key, users_email_key)은 SignUp의 중복 에러 판별에 쓰임
+CREATE TABLE IF NOT EXISTS users (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    username TEXT NOT NULL,
+    name TEXT NOT NULL,
+    phone TEXT,
+    phone_verified BOOLEAN NOT NULL DEFAULT false,
+    email TEXT NOT NULL,
+    password_hash TEXT NOT NULL,
+    nickname TEXT,
+    avatar_url TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT users_username_key UNIQUE (username),
+    CONSTRAINT users_email_key UNIQUE (email)
+);
+
+-- updated_at 자동 갱신
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS trigger AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS users_set_updated_at ON users;
+CREATE TRIGGER users_set_updated_at
+    BEFORE UPDATE ON users
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
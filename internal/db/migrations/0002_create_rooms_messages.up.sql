@@ -0,0 +1,30 @@
+-- 채팅 기본 테이블
+-- 이 마이그레이션 도입 전의 DB에도 그대로 적용되도록 IF NOT EXISTS로 작성함
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE IF NOT EXISTS rooms (
+    room_id TEXT PRIMARY KEY,
+    user1_id TEXT NOT NULL,
+    user2_id TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user1_id, user2_id)
+);
+
+CREATE TABLE IF NOT EXISTS messages (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    room_id TEXT NOT NULL REFERENCES rooms(room_id),
+    sender_id TEXT NOT NULL,
+    username TEXT NOT NULL,
+    message_content TEXT NOT NULL,
+    sent_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 예전 스키마로 만들어진 테이블에 빠진 컬럼 추가
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS user1_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS user2_id TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS username TEXT NOT NULL DEFAULT 'unknown';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS sender_id TEXT NOT NULL DEFAULT 'unknown';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS message_content TEXT NOT NULL DEFAULT '';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS sent_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS client_msg_id TEXT;
@@ -0,0 +1,4 @@
+-- 바뀐 방 ID는 되돌릴 수 없으므로 FK만 원래대로 돌림
+ALTER TABLE messages DROP CONSTRAINT IF EXISTS messages_room_id_fkey;
+ALTER TABLE messages ADD CONSTRAINT messages_room_id_fkey
+    FOREIGN KEY (room_id) REFERENCES rooms(room_id);
@@ -0,0 +1,74 @@
+-- 예전 방 ID(두 UUID 앞 3글자씩, 6글자)는 서로 다른 두 쌍이 충돌할 수 있어서 서버가 발급하는 UUID로 바꿈
+
+-- 1. messages.room_id FK에 ON UPDATE CASCADE 적용 (방 ID를 바꾸면 메시지도 같이 바뀌도록)
+ALTER TABLE messages DROP CONSTRAINT IF EXISTS messages_room_id_fkey;
+ALTER TABLE messages ADD CONSTRAINT messages_room_id_fkey
+    FOREIGN KEY (room_id) REFERENCES rooms(room_id) ON UPDATE CASCADE;
+
+-- 2. 충돌한 방에 섞여 저장된 메시지를 실제 대화 쌍의 방으로 나눔
+--    예전 서버는 방 ID가 겹치면 먼저 만든 쌍의 방에 다른 쌍의 메시지도 같이 저장했음
+--    메시지마다 그 방 ID가 나오는 유저 쌍(가입 순서대로 UUID 앞 3글자씩) 중 보낸 사람이 들어 있고
+--    메시지를 보낼 때 둘 다 가입해 있던 쌍을 찾음 (보낸 사람이 없어진 메시지는 그대로 둠)
+CREATE TEMP TABLE legacy_message_pairs ON COMMIT DROP AS
+SELECT m.id AS message_id, m.room_id, a.username AS user_a, b.username AS user_b,
+       COUNT(*) OVER (PARTITION BY m.id) AS candidates
+FROM messages m
+JOIN users a ON left(a.id::text, 3) = left(m.room_id, 3)
+JOIN users b ON left(b.id::text, 3) = substr(m.room_id, 4, 3)
+WHERE length(m.room_id) = 6
+  AND a.id <> b.id
+  AND (a.created_at, a.id::text COLLATE "C") < (b.created_at, b.id::text COLLATE "C")
+  AND (m.sent_at IS NULL OR (a.created_at <= m.sent_at AND b.created_at <= m.sent_at))
+  AND m.username IN (a.username, b.username);
+
+--    가능한 쌍이 여럿이면 어느 대화의 메시지인지 알 수 없으므로 아무것도 바꾸지 않고 중단함
+--    (해당 메시지의 room_id를 직접 정리한 뒤 다시 실행)
+DO $$
+DECLARE
+    ambiguous TEXT;
+BEGIN
+    SELECT string_agg(DISTINCT room_id, ', ') INTO ambiguous
+    FROM legacy_message_pairs
+    WHERE candidates > 1;
+    IF ambiguous IS NOT NULL THEN
+        RAISE EXCEPTION 'legacy rooms % hold messages that more than one user pair could have sent; move them to the right pair''s room by hand and rerun the migration', ambiguous;
+    END IF;
+END $$;
+
+--    쌍이 하나로 정해지는 메시지는 그 쌍의 방으로 옮김 (그 쌍의 방이 없으면 새로 만듦)
+INSERT INTO rooms (room_id, user1_id, user2_id)
+SELECT gen_random_uuid()::text, pair.lo, pair.hi
+FROM (
+    SELECT DISTINCT
+        LEAST(user_a COLLATE "C", user_b COLLATE "C") AS lo,
+        GREATEST(user_a COLLATE "C", user_b COLLATE "C") AS hi
+    FROM legacy_message_pairs
+) pair
+WHERE NOT EXISTS (
+    SELECT 1 FROM rooms r
+    WHERE (r.user1_id = pair.lo AND r.user2_id = pair.hi)
+       OR (r.user1_id = pair.hi AND r.user2_id = pair.lo)
+);
+
+UPDATE messages m
+SET room_id = r.room_id
+FROM legacy_message_pairs p, rooms r
+WHERE p.message_id = m.id
+  AND ((r.user1_id = p.user_a AND r.user2_id = p.user_b)
+       OR (r.user1_id = p.user_b AND r.user2_id = p.user_a))
+  AND r.room_id <> m.room_id;
+
+-- 3. 두 유저 아이디를 정렬된 순서로 저장 (같은 쌍은 항상 같은 행)
+--    Go 코드와 같은 바이트 순서로 비교하기 위해 COLLATE "C" 사용
+UPDATE rooms
+SET user1_id = user2_id, user2_id = user1_id
+WHERE user1_id COLLATE "C" > user2_id COLLATE "C"
+  AND NOT EXISTS (
+      SELECT 1 FROM rooms r2
+      WHERE r2.user1_id = rooms.user2_id AND r2.user2_id = rooms.user1_id
+  );
+
+-- 4. UUID 형식이 아닌 기존 방 ID를 새 UUID로 교체
+UPDATE rooms
+SET room_id = gen_random_uuid()::text
+WHERE room_id !~ '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$';
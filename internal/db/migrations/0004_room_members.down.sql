@@ -0,0 +1,8 @@
+-- 그룹 방은 1:1 스키마로 표현할 수 없으므로 같이 지움
+DROP TABLE IF EXISTS room_members;
+DELETE FROM messages WHERE room_id IN (SELECT room_id FROM rooms WHERE room_type <> 'direct');
+DELETE FROM rooms WHERE room_type <> 'direct';
+ALTER TABLE rooms ALTER COLUMN user1_id SET NOT NULL;
+ALTER TABLE rooms ALTER COLUMN user2_id SET NOT NULL;
+ALTER TABLE rooms DROP COLUMN IF EXISTS title;
+ALTER TABLE rooms DROP COLUMN IF EXISTS room_type;
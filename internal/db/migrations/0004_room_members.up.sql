@@ -0,0 +1,28 @@
+-- 그룹 채팅방
+-- 1:1 방은 기존처럼 user1_id/user2_id로 쌍을 구분하고, 모든 방(1:1 + 그룹)의 멤버는 room_members에 둠
+
+-- 1. rooms에 방 종류/이름 추가 (그룹 방은 user1_id/user2_id가 NULL)
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS room_type TEXT NOT NULL DEFAULT 'direct';
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS title TEXT NOT NULL DEFAULT '';
+ALTER TABLE rooms ALTER COLUMN user1_id DROP NOT NULL;
+ALTER TABLE rooms ALTER COLUMN user2_id DROP NOT NULL;
+
+-- 2. room_members 테이블 생성
+CREATE TABLE IF NOT EXISTS room_members (
+    room_id TEXT NOT NULL REFERENCES rooms(room_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    role TEXT NOT NULL DEFAULT 'member',
+    joined_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (room_id, user_id)
+);
+
+-- 3. 읽음 위치 (messages의 (sent_at, id) 순서 기준)
+ALTER TABLE room_members ADD COLUMN IF NOT EXISTS last_read_message_id UUID;
+ALTER TABLE room_members ADD COLUMN IF NOT EXISTS last_read_sent_at TIMESTAMP WITH TIME ZONE;
+
+-- 4. 기존 1:1 방의 두 사람을 room_members로 옮김 (이미 있으면 무시)
+INSERT INTO room_members (room_id, user_id)
+SELECT room_id, user1_id FROM rooms WHERE room_type = 'direct' AND user1_id IS NOT NULL
+UNION
+SELECT room_id, user2_id FROM rooms WHERE room_type = 'direct' AND user2_id IS NOT NULL
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+-- 되돌릴 수 없음: 임시값('TEMP_USER_<username>')을 실제 유저 ID로 바꾼 데이터 보정이라 원래 값이 남아 있지 않음
+-- SQL 없이 주석만 있는 down 파일은 MigrateDown이 실행하지 않고 에러로 거부함
@@ -0,0 +1,5 @@
+-- 예전 서버는 sender_id에 'TEMP_USER_<username>' 임시값을 저장했으므로 실제 유저 ID로 바꿈
+UPDATE messages m
+SET sender_id = u.id::text
+FROM users u
+WHERE m.sender_id LIKE 'TEMP\_USER\_%' AND u.username = m.username;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS uq_messages_client_msg;
+DROP INDEX IF EXISTS idx_room_members_user;
+DROP INDEX IF EXISTS idx_messages_room_sent;
@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_messages_room_sent ON messages (room_id, sent_at DESC);
+CREATE INDEX IF NOT EXISTS idx_room_members_user ON room_members (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_messages_client_msg ON messages (room_id, sender_id, client_msg_id) WHERE client_msg_id IS NOT NULL;
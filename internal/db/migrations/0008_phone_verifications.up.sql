@@ -0,0 +1,13 @@
+-- 전화번호 인증번호 (코드 원문은 저장하지 않고 id/번호와 함께 해시한 값만 저장)
+-- phone은 숫자(와 앞의 +)만 남긴 정규화된 번호
+CREATE TABLE IF NOT EXISTS phone_verifications (
+    id UUID PRIMARY KEY,
+    phone TEXT NOT NULL,
+    code_hash TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    verified_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_phone_verifications_phone ON phone_verifications (phone, created_at DESC);
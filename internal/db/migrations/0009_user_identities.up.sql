@@ -0,0 +1,11 @@
+-- 소셜 로그인 계정 연결 (카카오/네이버 회원번호 → 우리 유저)
+-- 유저 한 명은 제공자마다 계정 하나만 연결할 수 있음
+CREATE TABLE IF NOT EXISTS user_identities (
+    provider TEXT NOT NULL,
+    provider_user_id TEXT NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    email TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (provider, provider_user_id),
+    CONSTRAINT user_identities_user_provider_key UNIQUE (user_id, provider)
+);
@@ -0,0 +1,3 @@
+-- 관리자가 차단한 계정 (NULL이면 정상)
+-- 차단된 유저는 로그인/소셜 로그인이 거부되고, 차단할 때 모든 세션이 끊김
+ALTER TABLE users ADD COLUMN IF NOT EXISTS disabled_at TIMESTAMP WITH TIME ZONE;
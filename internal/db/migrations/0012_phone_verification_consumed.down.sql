@@ -0,0 +1 @@
+ALTER TABLE phone_verifications DROP COLUMN IF EXISTS consumed_at;
@@ -0,0 +1,2 @@
+-- 인증을 마친 번호 인증은 SignUp / UpdateProfile에서 인증 ID로 한 번만 쓸 수 있음 (쓴 시각)
+ALTER TABLE phone_verifications ADD COLUMN IF NOT EXISTS consumed_at TIMESTAMP WITH TIME ZONE;
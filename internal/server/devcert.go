@@ -0,0 +1,159 @@
+package server
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DevCertHosts: 개발용 인증서에 기본으로 넣는 이름 (로컬 실행 + docker-compose 서비스 이름)
+var DevCertHosts = []string{"localhost", "127.0.0.1", "::1", "usersvc", "chatsvc", "chatgw"}
+
+// DevCertFiles: GenerateDevCerts가 만든 파일 경로 (tls.ca_file / cert_file / key_file에 그대로 씀)
+type DevCertFiles struct {
+	CAFile   string
+	CertFile string
+	KeyFile  string
+}
+
+// GenerateDevCerts: dir에 개발용 CA(ca.pem, ca.key.pem)를 만들고 (이미 있으면 그대로 씀)
+// 그 CA로 서명한 인증서(<name>.pem, <name>.key.pem)를 새로 발급함
+// 인증서는 서버/클라이언트 인증 둘 다에 쓸 수 있어서 하나로 서버 TLS와 mTLS를 모두 시험할 수 있음
+// 이미 떠 있는 서버는 인증서 파일이 바뀐 것을 보고 다시 읽음
+func GenerateDevCerts(dir, name string, hosts []string) (DevCertFiles, error) {
+	files := DevCertFiles{
+		CAFile:   filepath.Join(dir, "ca.pem"),
+		CertFile: filepath.Join(dir, name+".pem"),
+		KeyFile:  filepath.Join(dir, name+".key.pem"),
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return files, err
+	}
+
+	caCert, caKey, err := loadOrCreateDevCA(files.CAFile, filepath.Join(dir, "ca.key.pem"))
+	if err != nil {
+		return files, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return files, err
+	}
+	tmpl, err := certTemplate(name, 90*24*time.Hour)
+	if err != nil {
+		return files, err
+	}
+	tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+	tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, h)
+		}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, key.Public(), caKey)
+	if err != nil {
+		return files, err
+	}
+
+	// 키를 먼저 쓰고 인증서를 나중에 씀 (서버가 중간에 읽으면 짝이 맞지 않아 이전 인증서를 계속 씀)
+	if err := writeKey(files.KeyFile, key); err != nil {
+		return files, err
+	}
+	return files, writePEM(files.CertFile, "CERTIFICATE", der, 0o644)
+}
+
+// loadOrCreateDevCA: CA 파일이 있으면 읽고, 없으면 10년짜리 자체 서명 CA를 만듦
+func loadOrCreateDevCA(certFile, keyFile string) (*x509.Certificate, crypto.Signer, error) {
+	certPEM, certErr := os.ReadFile(certFile)
+	keyPEM, keyErr := os.ReadFile(keyFile)
+	if certErr == nil && keyErr == nil {
+		return parseDevCA(certPEM, keyPEM)
+	}
+	if !errors.Is(certErr, os.ErrNotExist) || !errors.Is(keyErr, os.ErrNotExist) {
+		return nil, nil, fmt.Errorf("%s and %s must both exist or both be missing", certFile, keyFile)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	tmpl, err := certTemplate("gRPC Chat dev CA", 10*365*24*time.Hour)
+	if err != nil {
+		return nil, nil, err
+	}
+	tmpl.IsCA = true
+	tmpl.BasicConstraintsValid = true
+	tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := writeKey(keyFile, key); err != nil {
+		return nil, nil, err
+	}
+	if err := writePEM(certFile, "CERTIFICATE", der, 0o644); err != nil {
+		return nil, nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	return cert, key, err
+}
+
+func parseDevCA(certPEM, keyPEM []byte) (*x509.Certificate, crypto.Signer, error) {
+	certBlock, _ := pem.Decode(certPEM)
+	keyBlock, _ := pem.Decode(keyPEM)
+	if certBlock == nil || keyBlock == nil {
+		return nil, nil, fmt.Errorf("dev CA files are not PEM")
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := x509.ParsePKCS8PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported CA key type %T", key)
+	}
+	return cert, signer, nil
+}
+
+func certTemplate(commonName string, validFor time.Duration) (*x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{"gRPC Chat (development)"}},
+		NotBefore:    now.Add(-time.Hour), // 시계가 조금 어긋난 컨테이너에서도 바로 쓸 수 있도록
+		NotAfter:     now.Add(validFor),
+	}, nil
+}
+
+func writeKey(path string, key crypto.Signer) error {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return err
+	}
+	return writePEM(path, "PRIVATE KEY", der, 0o600)
+}
+
+func writePEM(path, blockType string, der []byte, perm os.FileMode) error {
+	return os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), perm)
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"log"
+	"net"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// ServeUntilSignal은 SIGINT/SIGTERM을 받을 때까지 grpcServer를 서빙합니다.
+// 신호를 받으면 drain(접속 중인 스트림 정리 등)을 먼저 부르고 GracefulStop으로 진행 중인 RPC가 끝나기를 기다립니다.
+// drain과 GracefulStop을 합쳐 timeout이 지나면 Stop으로 남은 연결을 강제로 끊습니다.
+// drain이 nil이면 바로 GracefulStop합니다.
+func ServeUntilSignal(grpcServer *grpc.Server, lis net.Listener, timeout time.Duration, drain func(ctx context.Context)) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- grpcServer.Serve(lis)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+	// 두 번째 신호는 기본 동작(즉시 종료)으로
+	stop()
+	log.Printf("종료 신호를 받았습니다. 최대 %s 동안 정리합니다...", timeout)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	Shutdown(shutdownCtx, grpcServer, drain)
+	return nil
+}
+
+// Shutdown은 drain을 부른 뒤 GracefulStop으로 진행 중인 RPC가 끝나기를 기다립니다.
+// ctx가 먼저 끝나면 Stop으로 남은 연결을 강제로 끊습니다. 어느 쪽이든 서버가 멈춘 뒤에 반환합니다.
+func Shutdown(ctx context.Context, grpcServer *grpc.Server, drain func(ctx context.Context)) {
+	if drain != nil {
+		drain(ctx)
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		log.Println("서버가 정상 종료되었습니다.")
+	case <-ctx.Done():
+		log.Println("종료 제한 시간 초과: 남은 연결을 강제로 끊습니다.")
+		grpcServer.Stop()
+		<-stopped
+	}
+}
@@ -0,0 +1,209 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+)
+
+// ServerCredentials: tls 설정에 맞는 gRPC 서버 옵션 (tls.enabled가 꺼져 있으면 평문)
+// 인증서/CA 파일은 새 연결의 핸드셰이크마다 바뀌었는지 확인해서 다시 읽으므로,
+// 인증서를 갱신할 때 서버를 다시 시작할 필요가 없음 (이미 맺은 연결은 그대로)
+func ServerCredentials(cfg config.TLSConfig) (grpc.ServerOption, error) {
+	if !cfg.Enabled {
+		log.Println("tls.enabled가 꺼져 있어 평문 gRPC로 서빙합니다 (로컬 개발용, 비밀번호가 그대로 전송됨)")
+		return grpc.Creds(insecure.NewCredentials()), nil
+	}
+	tlsCfg, err := NewServerTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.Creds(credentials.NewTLS(tlsCfg)), nil
+}
+
+// DialCredentials: tls 설정에 맞는 gRPC 클라이언트 옵션 (tls.enabled가 꺼져 있으면 평문)
+func DialCredentials(cfg config.TLSConfig) (grpc.DialOption, error) {
+	if !cfg.Enabled {
+		return grpc.WithTransportCredentials(insecure.NewCredentials()), nil
+	}
+	tlsCfg, err := NewClientTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)), nil
+}
+
+// NewServerTLSConfig: 서버용 tls.Config
+// client_auth가 optional이면 클라이언트 인증서를 보냈을 때만 ca_file로 검증하고, require면 없으면 거부함 (mTLS)
+func NewServerTLSConfig(cfg config.TLSConfig) (*tls.Config, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, fmt.Errorf("tls.cert_file and tls.key_file are required when tls is enabled")
+	}
+	clientAuth := tls.NoClientCert
+	switch cfg.ClientAuth {
+	case "", "none":
+	case "optional":
+		clientAuth = tls.VerifyClientCertIfGiven
+	case "require":
+		clientAuth = tls.RequireAndVerifyClientCert
+	default:
+		return nil, fmt.Errorf("unknown tls.client_auth %q", cfg.ClientAuth)
+	}
+
+	certs := newCertReloader(cfg.CertFile, cfg.KeyFile, cfg.CAFile)
+	if err := certs.reload(); err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// 핸드셰이크마다 최신 인증서 / 클라이언트 CA로 설정을 만듦
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := certs.current()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientAuth:   clientAuth,
+				ClientCAs:    pool,
+				NextProtos:   []string{"h2"},
+			}, nil
+		},
+	}, nil
+}
+
+// NewClientTLSConfig: 클라이언트용 tls.Config
+// cert_file/key_file이 있으면 서버가 요청할 때 클라이언트 인증서로 보냄 (바뀌면 다시 읽음)
+func NewClientTLSConfig(cfg config.TLSConfig) (*tls.Config, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: cfg.ServerName}
+	if cfg.CAFile != "" {
+		pool, err := loadCertPool(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if cfg.CertFile != "" && cfg.KeyFile != "" {
+		certs := newCertReloader(cfg.CertFile, cfg.KeyFile, "")
+		if err := certs.reload(); err != nil {
+			return nil, err
+		}
+		tlsCfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := certs.current()
+			return cert, nil
+		}
+	}
+	return tlsCfg, nil
+}
+
+// certReloader: 인증서 / 개인 키 / CA 파일을 들고 있다가 파일이 바뀌면 다시 읽음
+// 새로 읽은 파일이 잘못됐으면 (키를 반쯤 쓰다 만 경우 등) 로그만 남기고 이전 인증서를 계속 씀
+type certReloader struct {
+	certFile, keyFile, caFile string
+
+	mu     sync.Mutex
+	stamps []fileStamp
+	cert   *tls.Certificate
+	pool   *x509.CertPool // caFile이 없으면 nil
+}
+
+// fileStamp: 파일이 바뀌었는지 비교할 때 쓰는 값
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func newCertReloader(certFile, keyFile, caFile string) *certReloader {
+	return &certReloader{certFile: certFile, keyFile: keyFile, caFile: caFile}
+}
+
+func (r *certReloader) files() []string {
+	if r.caFile == "" {
+		return []string{r.certFile, r.keyFile}
+	}
+	return []string{r.certFile, r.keyFile, r.caFile}
+}
+
+func (r *certReloader) stat() ([]fileStamp, error) {
+	var stamps []fileStamp
+	for _, path := range r.files() {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		stamps = append(stamps, fileStamp{modTime: fi.ModTime(), size: fi.Size()})
+	}
+	return stamps, nil
+}
+
+// reload: 파일을 모두 다시 읽음
+func (r *certReloader) reload() error {
+	stamps, err := r.stat()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("load %s: %w", r.certFile, err)
+	}
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		if pool, err = loadCertPool(r.caFile); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stamps, r.cert, r.pool = stamps, &cert, pool
+	return nil
+}
+
+// current: 파일이 바뀌었으면 다시 읽은 뒤 지금 인증서와 CA를 돌려줌
+func (r *certReloader) current() (*tls.Certificate, *x509.CertPool) {
+	if stamps, err := r.stat(); err == nil && r.changed(stamps) {
+		if err := r.reload(); err != nil {
+			log.Printf("TLS 인증서를 다시 읽지 못해 이전 인증서를 계속 씁니다: %v", err)
+			// 같은 실패를 핸드셰이크마다 되풀이하지 않도록 다음 변경까지 기다림
+			r.mu.Lock()
+			r.stamps = stamps
+			r.mu.Unlock()
+		} else {
+			log.Printf("TLS 인증서를 다시 읽었습니다: %s", r.certFile)
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cert, r.pool
+}
+
+func (r *certReloader) changed(stamps []fileStamp) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, s := range stamps {
+		if !s.modTime.Equal(r.stamps[i].modTime) || s.size != r.stamps[i].size {
+			return true
+		}
+	}
+	return false
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("%s: no certificates found", path)
+	}
+	return pool, nil
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+)
+
+// startTLSServer: health 서비스만 있는 TLS gRPC 서버를 띄우고 주소를 돌려줌
+func startTLSServer(t *testing.T, cfg config.TLSConfig) string {
+	t.Helper()
+	creds, err := ServerCredentials(cfg)
+	if err != nil {
+		t.Fatalf("ServerCredentials: %v", err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(creds)
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+// check: 새 연결로 Health.Check를 한 번 부르고 서버 인증서를 돌려줌
+func check(t *testing.T, addr string, cfg config.TLSConfig) (*x509.Certificate, error) {
+	t.Helper()
+	creds, err := DialCredentials(cfg)
+	if err != nil {
+		t.Fatalf("DialCredentials: %v", err)
+	}
+	conn, err := grpc.NewClient(addr, creds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var p peer.Peer
+	if _, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{}, grpc.Peer(&p)); err != nil {
+		return nil, err
+	}
+	return p.AuthInfo.(credentials.TLSInfo).State.PeerCertificates[0], nil
+}
+
+func TestTLSAndMutualTLS(t *testing.T) {
+	files, err := GenerateDevCerts(t.TempDir(), "dev", DevCertHosts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverCfg := config.TLSConfig{Enabled: true, CertFile: files.CertFile, KeyFile: files.KeyFile, CAFile: files.CAFile, ClientAuth: "none"}
+	clientCfg := config.TLSConfig{Enabled: true, CAFile: files.CAFile}
+	mutualCfg := config.TLSConfig{Enabled: true, CAFile: files.CAFile, CertFile: files.CertFile, KeyFile: files.KeyFile}
+
+	addr := startTLSServer(t, serverCfg)
+	if _, err := check(t, addr, clientCfg); err != nil {
+		t.Fatalf("TLS call: %v", err)
+	}
+	// 개발용 CA를 모르는 클라이언트는 서버 인증서를 믿지 않음
+	if _, err := check(t, addr, config.TLSConfig{Enabled: true}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("call without dev CA: err = %v, want Unavailable", err)
+	}
+	// 평문 클라이언트는 TLS 서버에 붙지 못함
+	if _, err := check(t, addr, config.TLSConfig{}); err == nil {
+		t.Fatal("plaintext call to TLS server succeeded")
+	}
+
+	serverCfg.ClientAuth = "require"
+	mtlsAddr := startTLSServer(t, serverCfg)
+	if _, err := check(t, mtlsAddr, clientCfg); err == nil {
+		t.Fatal("call without client certificate succeeded with client_auth=require")
+	}
+	if _, err := check(t, mtlsAddr, mutualCfg); err != nil {
+		t.Fatalf("mTLS call: %v", err)
+	}
+}
+
+func TestServerReloadsCertificate(t *testing.T) {
+	dir := t.TempDir()
+	files, err := GenerateDevCerts(dir, "dev", DevCertHosts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := startTLSServer(t, config.TLSConfig{Enabled: true, CertFile: files.CertFile, KeyFile: files.KeyFile, ClientAuth: "none"})
+	clientCfg := config.TLSConfig{Enabled: true, CAFile: files.CAFile}
+
+	before, err := check(t, addr, clientCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 같은 CA로 다시 발급하면 다음 연결부터 새 인증서
+	if _, err := GenerateDevCerts(dir, "dev", DevCertHosts); err != nil {
+		t.Fatal(err)
+	}
+	after, err := check(t, addr, clientCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.SerialNumber.Cmp(before.SerialNumber) == 0 {
+		t.Fatal("server still presents the old certificate after the files changed")
+	}
+}
@@ -0,0 +1,263 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 관리자 작업으로 세션을 끊은 이유 (auth_sessions.revoke_reason)
+const (
+	revokeReasonDisabled      = "disabled"
+	revokeReasonPasswordReset = "password_reset"
+	revokeReasonRoleChanged   = "role_changed"
+	revokeReasonForcedLogout  = "forced_logout"
+)
+
+// 임시 비밀번호 (헷갈리는 0/O, 1/l/I 제외)
+const (
+	tempPasswordAlphabet = "abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	tempPasswordLength   = 12
+)
+
+const adminUserColumns = `id, username, name, phone, phone_verified, email, nickname, avatar_url, created_at, disabled_at, role`
+
+func scanAdminUser(row pgx.Row) (*User, error) {
+	var u User
+	err := row.Scan(&u.ID, &u.Username, &u.Name, &u.Phone, &u.PhoneVerified, &u.Email, &u.Nickname, &u.AvatarURL, &u.CreatedAt, &u.DisabledAt, &u.Role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return &u, nil
+}
+
+// revokeUserSessions: 유저의 살아 있는 세션을 모두 끊고 끊은 수를 돌려줌 (access token도 세션 확인에서 바로 거부됨)
+func revokeUserSessions(ctx context.Context, tx pgx.Tx, userID, reason string) (int64, error) {
+	const q = `
+		UPDATE auth_sessions
+		SET revoked_at = now(), revoke_reason = $2
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`
+	tag, err := tx.Exec(ctx, q, userID, reason)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// ListUsers: 최근 가입순 유저 목록 (검색어 없이 전체를 훑을 때)
+func (s *service) ListUsers(ctx context.Context, limit, offset int32) ([]*User, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	q := `SELECT ` + adminUserColumns + ` FROM users ORDER BY created_at DESC, id LIMIT $1 OFFSET $2`
+	rows, err := s.db.Query(ctx, q, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []*User{}
+	for rows.Next() {
+		u, err := scanAdminUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
+
+// SetUserDisabled: 계정 차단/해제. 차단하면 모든 세션을 끊음 (이미 차단된 계정은 차단 시각을 유지)
+func (s *service) SetUserDisabled(ctx context.Context, userID string, disabled bool) (*User, error) {
+	if !uuidPattern.MatchString(userID) {
+		return nil, ErrUserNotFound
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := `
+		UPDATE users
+		SET disabled_at = CASE WHEN $2 THEN COALESCE(disabled_at, now()) END
+		WHERE id = $1
+		RETURNING ` + adminUserColumns
+	u, err := scanAdminUser(tx.QueryRow(ctx, q, userID, disabled))
+	if err != nil {
+		return nil, err
+	}
+	if disabled {
+		if _, err := revokeUserSessions(ctx, tx, userID, revokeReasonDisabled); err != nil {
+			return nil, err
+		}
+	}
+	return u, tx.Commit(ctx)
+}
+
+// SetUserRole: 역할 변경. 바뀌면 새 역할이 토큰에 반영되도록 모든 세션을 끊음
+func (s *service) SetUserRole(ctx context.Context, userID string, role Role) (*User, error) {
+	if _, err := ParseRole(string(role)); err != nil {
+		return nil, err
+	}
+	if !uuidPattern.MatchString(userID) {
+		return nil, ErrUserNotFound
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var previous Role
+	if err := tx.QueryRow(ctx, `SELECT role FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&previous); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	q := `UPDATE users SET role = $2 WHERE id = $1 RETURNING ` + adminUserColumns
+	u, err := scanAdminUser(tx.QueryRow(ctx, q, userID, string(role)))
+	if err != nil {
+		return nil, err
+	}
+	if previous != role {
+		if _, err := revokeUserSessions(ctx, tx, userID, revokeReasonRoleChanged); err != nil {
+			return nil, err
+		}
+	}
+	return u, tx.Commit(ctx)
+}
+
+// ForceLogout: 유저의 모든 세션을 끊고 끊은 수를 돌려줌
+func (s *service) ForceLogout(ctx context.Context, userID string) (int64, error) {
+	if !uuidPattern.MatchString(userID) {
+		return 0, ErrUserNotFound
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)`, userID).Scan(&exists); err != nil {
+		return 0, err
+	}
+	if !exists {
+		return 0, ErrUserNotFound
+	}
+	n, err := revokeUserSessions(ctx, tx, userID, revokeReasonForcedLogout)
+	if err != nil {
+		return 0, err
+	}
+	return n, tx.Commit(ctx)
+}
+
+// DeleteUser: 유저 삭제
+// 세션/refresh token/소셜 계정 연결은 FK로 같이 지워지고, 방 멤버 자격(username 기준)은 여기서 지움
+// 보낸 메시지는 대화 기록으로 남김
+// 1:1 방은 (user1_id, user2_id) 쌍이 username 기준이라, 같은 username으로 다시 가입한 유저가 예전 대화를 이어받지 않도록
+// 삭제한 유저 쪽 아이디를 NULL로 비움 (상대방에게는 기록이 그대로 남고, 새 유저와는 새 방이 만들어짐)
+func (s *service) DeleteUser(ctx context.Context, userID string) error {
+	if !uuidPattern.MatchString(userID) {
+		return ErrUserNotFound
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var username string
+	if err := tx.QueryRow(ctx, `DELETE FROM users WHERE id = $1 RETURNING username`, userID).Scan(&username); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM room_members WHERE user_id = $1`, username); err != nil {
+		return err
+	}
+	const qDirectRooms = `
+		UPDATE rooms
+		SET user1_id = NULLIF(user1_id, $1), user2_id = NULLIF(user2_id, $1)
+		WHERE room_type = 'direct' AND (user1_id = $1 OR user2_id = $1)
+	`
+	if _, err := tx.Exec(ctx, qDirectRooms, username); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// ResetPassword: 비밀번호를 newPassword로 바꾸고 모든 세션을 끊음
+// newPassword가 비어 있으면 임시 비밀번호를 만들어서 돌려줌 (직접 정한 경우 빈 문자열)
+func (s *service) ResetPassword(ctx context.Context, userID, newPassword string) (string, error) {
+	if !uuidPattern.MatchString(userID) {
+		return "", ErrUserNotFound
+	}
+
+	var generated string
+	if newPassword == "" {
+		p, err := newTemporaryPassword()
+		if err != nil {
+			return "", err
+		}
+		newPassword, generated = p, p
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `UPDATE users SET password_hash = $1 WHERE id = $2`, string(hashed), userID)
+	if err != nil {
+		return "", err
+	}
+	if tag.RowsAffected() == 0 {
+		return "", ErrUserNotFound
+	}
+	if _, err := revokeUserSessions(ctx, tx, userID, revokeReasonPasswordReset); err != nil {
+		return "", err
+	}
+	return generated, tx.Commit(ctx)
+}
+
+func newTemporaryPassword() (string, error) {
+	b := make([]byte, tempPasswordLength)
+	max := big.NewInt(int64(len(tempPasswordAlphabet)))
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = tempPasswordAlphabet[n.Int64()]
+	}
+	return string(b), nil
+}
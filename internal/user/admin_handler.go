@@ -0,0 +1,466 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/adminpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 관리자 API 페이지 크기
+const (
+	defaultAdminRoomPageSize    = 20
+	maxAdminRoomPageSize        = 100
+	defaultAdminMessagePageSize = 100
+	maxAdminMessagePageSize     = 500
+	defaultAuditPageSize        = 50
+	maxAuditPageSize            = 200
+)
+
+// AdminHandler: gRPC AdminServiceServer 구현체 (운영자용)
+// 메서드별 역할은 인터셉터(methodRoles)가 확인하고, 여기서는 대상 유저에 대한 권한과 감사 기록을 처리함
+type AdminHandler struct {
+	adminpb.UnimplementedAdminServiceServer
+	svc   Service
+	chat  ChatRepository
+	audit AuditLog
+}
+
+func NewAdminHandler(svc Service, chat ChatRepository, audit AuditLog) *AdminHandler {
+	return &AdminHandler{svc: svc, chat: chat, audit: audit}
+}
+
+// record: 관리자 작업을 하기 전에 감사 기록을 남김
+// 기록하지 못하면 Internal을 돌려주고 작업은 하지 않음 (기록 없이 끝난 관리자 작업이 남지 않도록)
+func (h *AdminHandler) record(ctx context.Context, action, targetType, targetID string, detail map[string]string) (*AuditEntry, error) {
+	e := &AuditEntry{
+		ActorRole:  RoleFromContext(ctx),
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Detail:     detail,
+	}
+	e.ActorID, _ = UserIDFromContext(ctx)
+	e.ActorUsername, _ = UsernameFromContext(ctx)
+
+	log.Printf("관리자 작업: %s(%s) %s %s %s %v", e.ActorUsername, e.ActorRole, action, targetType, targetID, detail)
+	if err := h.audit.Record(ctx, e); err != nil {
+		log.Printf("감사 기록 저장 실패로 작업을 중단합니다 (%s %s %s): %v", action, targetType, targetID, err)
+		return nil, status.Error(codes.Internal, "failed to write audit log")
+	}
+	return e, nil
+}
+
+// finish: 작업 결과(result)나 실패 이유(err)를 감사 기록에 더함
+// 작업은 record로 이미 기록됐으므로 여기서 저장에 실패하면 로그로만 남김
+func (h *AdminHandler) finish(ctx context.Context, e *AuditEntry, result map[string]string, err error) {
+	if err != nil {
+		result = map[string]string{"error": err.Error()}
+	}
+	if len(result) == 0 {
+		return
+	}
+	if err := h.audit.Annotate(ctx, e.ID, result); err != nil {
+		log.Printf("감사 기록에 결과 저장 실패 (#%d %s %v): %v", e.ID, e.Action, result, err)
+	}
+}
+
+// targetUser: 작업 대상 유저를 찾고 호출자가 그 유저를 관리할 수 있는지 확인
+// 자기 계정은 건드릴 수 없고, admin이 아니면 자기보다 낮은 역할의 유저만 관리할 수 있음
+func (h *AdminHandler) targetUser(ctx context.Context, userID string) (*User, error) {
+	if !uuidPattern.MatchString(userID) {
+		return nil, status.Error(codes.NotFound, ErrUserNotFound.Error())
+	}
+	u, err := h.svc.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, userError("failed to get user", err)
+	}
+
+	if myID, _ := UserIDFromContext(ctx); myID == u.ID {
+		return nil, status.Error(codes.FailedPrecondition, "cannot moderate your own account")
+	}
+	if actor := RoleFromContext(ctx); actor != RoleAdmin && !actor.Outranks(u.Role) {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot moderate a user with the %s role", u.Role)
+	}
+	return u, nil
+}
+
+func toAdminUser(u *User) *adminpb.AdminUser {
+	if u == nil {
+		return nil
+	}
+	out := &adminpb.AdminUser{
+		Id:            u.ID,
+		Username:      u.Username,
+		Name:          u.Name,
+		PhoneVerified: u.PhoneVerified,
+		Email:         u.Email,
+		CreatedAt:     u.CreatedAt.Unix(),
+		Disabled:      u.DisabledAt != nil,
+		Role:          string(u.Role),
+	}
+	if u.Phone != nil {
+		out.Phone = *u.Phone
+	}
+	if u.Nickname != nil {
+		out.Nickname = *u.Nickname
+	}
+	if u.DisabledAt != nil {
+		out.DisabledAt = u.DisabledAt.Unix()
+	}
+	return out
+}
+
+// userError: 유저 관리 에러 → gRPC status
+func userError(msg string, err error) error {
+	if err == ErrUserNotFound {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+func (h *AdminHandler) ListUsers(ctx context.Context, req *adminpb.ListUsersRequest) (*adminpb.ListUsersResponse, error) {
+	var (
+		users []*User
+		err   error
+	)
+	if req.GetQuery() != "" {
+		users, err = h.svc.SearchUsers(ctx, req.GetQuery(), req.GetLimit(), req.GetOffset())
+	} else {
+		users, err = h.svc.ListUsers(ctx, req.GetLimit(), req.GetOffset())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+
+	resp := &adminpb.ListUsersResponse{Users: make([]*adminpb.AdminUser, 0, len(users))}
+	for _, u := range users {
+		resp.Users = append(resp.Users, toAdminUser(u))
+	}
+	return resp, nil
+}
+
+func (h *AdminHandler) GetUser(ctx context.Context, req *adminpb.GetUserRequest) (*adminpb.GetUserResponse, error) {
+	if !uuidPattern.MatchString(req.GetUserId()) {
+		return nil, status.Error(codes.NotFound, ErrUserNotFound.Error())
+	}
+	u, err := h.svc.GetProfile(ctx, req.GetUserId())
+	if err != nil {
+		return nil, userError("failed to get user", err)
+	}
+	return &adminpb.GetUserResponse{User: toAdminUser(u)}, nil
+}
+
+func (h *AdminHandler) SetUserDisabled(ctx context.Context, req *adminpb.SetUserDisabledRequest) (*adminpb.SetUserDisabledResponse, error) {
+	target, err := h.targetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	e, err := h.record(ctx, "SetUserDisabled", AuditTargetUser, target.ID, map[string]string{
+		"username": target.Username,
+		"disabled": strconv.FormatBool(req.GetDisabled()),
+		"reason":   req.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	u, err := h.svc.SetUserDisabled(ctx, req.GetUserId(), req.GetDisabled())
+	h.finish(ctx, e, nil, err)
+	if err != nil {
+		return nil, userError("failed to update user", err)
+	}
+	return &adminpb.SetUserDisabledResponse{User: toAdminUser(u)}, nil
+}
+
+func (h *AdminHandler) DeleteUser(ctx context.Context, req *adminpb.DeleteUserRequest) (*adminpb.DeleteUserResponse, error) {
+	target, err := h.targetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	e, err := h.record(ctx, "DeleteUser", AuditTargetUser, target.ID, map[string]string{"username": target.Username})
+	if err != nil {
+		return nil, err
+	}
+	err = h.svc.DeleteUser(ctx, req.GetUserId())
+	h.finish(ctx, e, nil, err)
+	if err != nil {
+		return nil, userError("failed to delete user", err)
+	}
+	return &adminpb.DeleteUserResponse{}, nil
+}
+
+func (h *AdminHandler) ResetPassword(ctx context.Context, req *adminpb.ResetPasswordRequest) (*adminpb.ResetPasswordResponse, error) {
+	target, err := h.targetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	// 비밀번호 자체는 기록하지 않음 (비워서 요청하면 임시 비밀번호가 발급됨)
+	e, err := h.record(ctx, "ResetPassword", AuditTargetUser, target.ID, map[string]string{
+		"username":  target.Username,
+		"temporary": strconv.FormatBool(req.GetNewPassword() == ""),
+	})
+	if err != nil {
+		return nil, err
+	}
+	temp, err := h.svc.ResetPassword(ctx, req.GetUserId(), req.GetNewPassword())
+	h.finish(ctx, e, nil, err)
+	if err != nil {
+		return nil, userError("failed to reset password", err)
+	}
+	return &adminpb.ResetPasswordResponse{TemporaryPassword: temp}, nil
+}
+
+func (h *AdminHandler) SetUserRole(ctx context.Context, req *adminpb.SetUserRoleRequest) (*adminpb.SetUserRoleResponse, error) {
+	role, err := ParseRole(req.GetRole())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	target, err := h.targetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	e, err := h.record(ctx, "SetUserRole", AuditTargetUser, target.ID, map[string]string{
+		"username": target.Username,
+		"from":     string(target.Role),
+		"to":       string(role),
+	})
+	if err != nil {
+		return nil, err
+	}
+	u, err := h.svc.SetUserRole(ctx, req.GetUserId(), role)
+	h.finish(ctx, e, nil, err)
+	if err != nil {
+		return nil, userError("failed to update role", err)
+	}
+	return &adminpb.SetUserRoleResponse{User: toAdminUser(u)}, nil
+}
+
+func (h *AdminHandler) ForceLogout(ctx context.Context, req *adminpb.ForceLogoutRequest) (*adminpb.ForceLogoutResponse, error) {
+	target, err := h.targetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	e, err := h.record(ctx, "ForceLogout", AuditTargetUser, target.ID, map[string]string{
+		"username": target.Username,
+		"reason":   req.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	n, err := h.svc.ForceLogout(ctx, req.GetUserId())
+	h.finish(ctx, e, map[string]string{"revoked_sessions": strconv.FormatInt(n, 10)}, err)
+	if err != nil {
+		return nil, userError("failed to revoke sessions", err)
+	}
+	return &adminpb.ForceLogoutResponse{RevokedSessions: n}, nil
+}
+
+// ListUserRooms: 유저가 속한 방 목록 (room_members는 username 기준이므로 유저를 먼저 찾음)
+func (h *AdminHandler) ListUserRooms(ctx context.Context, req *adminpb.ListUserRoomsRequest) (*adminpb.ListUserRoomsResponse, error) {
+	if !uuidPattern.MatchString(req.GetUserId()) {
+		return nil, status.Error(codes.NotFound, ErrUserNotFound.Error())
+	}
+	u, err := h.svc.GetProfile(ctx, req.GetUserId())
+	if err != nil {
+		return nil, userError("failed to get user", err)
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultAdminRoomPageSize
+	}
+	if limit > maxAdminRoomPageSize {
+		limit = maxAdminRoomPageSize
+	}
+	var after *RoomCursor
+	if req.GetPageToken() != "" {
+		if after, err = DecodeRoomCursor(req.GetPageToken()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+	}
+
+	rooms, err := h.chat.GetRoomsByUser(ctx, u.Username, after, limit+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rooms: %v", err)
+	}
+	resp := &adminpb.ListUserRoomsResponse{}
+	if len(rooms) > limit {
+		rooms = rooms[:limit]
+		resp.NextPageToken = RoomCursorOf(rooms[limit-1]).Encode()
+	}
+	for _, r := range rooms {
+		resp.Rooms = append(resp.Rooms, &adminpb.AdminRoom{
+			RoomId:             r.RoomID,
+			IsGroup:            r.RoomType == RoomTypeGroup,
+			Title:              r.Title,
+			MemberCount:        int32(r.MemberCount),
+			LastMessagePreview: r.LastMessagePreview,
+			LastActivityAt:     r.LastActivityAt.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+// GetRoomMessages: 방 기록을 오래된 순으로 한 페이지씩 (전체를 덤프할 때는 next_cursor로 반복)
+func (h *AdminHandler) GetRoomMessages(ctx context.Context, req *adminpb.GetRoomMessagesRequest) (*adminpb.GetRoomMessagesResponse, error) {
+	if _, err := h.chat.GetRoom(ctx, req.GetRoomId()); err != nil {
+		if err == ErrRoomNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get room: %v", err)
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultAdminMessagePageSize
+	}
+	if limit > maxAdminMessagePageSize {
+		limit = maxAdminMessagePageSize
+	}
+	var after *MessageCursor
+	if req.GetCursor() != "" {
+		var err error
+		if after, err = DecodeMessageCursor(req.GetCursor()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+	}
+
+	// 남의 대화를 보는 것이므로 페이지마다 읽기 전에 기록함 (어느 위치부터 읽었는지도 남김)
+	e, err := h.record(ctx, "GetRoomMessages", AuditTargetRoom, req.GetRoomId(), map[string]string{
+		"cursor": req.GetCursor(),
+		"limit":  strconv.Itoa(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	records, err := h.chat.GetMessagesAfter(ctx, req.GetRoomId(), after, limit+1)
+	h.finish(ctx, e, nil, err)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get messages: %v", err)
+	}
+
+	resp := &adminpb.GetRoomMessagesResponse{}
+	if len(records) > limit {
+		records = records[:limit]
+		resp.HasMore = true
+		resp.NextCursor = CursorOf(records[limit-1]).Encode()
+	}
+	for _, m := range records {
+		resp.Messages = append(resp.Messages, &adminpb.AdminMessage{
+			MessageId: m.ID,
+			RoomId:    m.RoomID,
+			SenderId:  m.SenderID,
+			Username:  m.Username,
+			Message:   m.MessageContent,
+			SentAt:    m.SentAt.Unix(),
+			Cursor:    CursorOf(m).Encode(),
+		})
+	}
+	return resp, nil
+}
+
+func (h *AdminHandler) DeleteMessage(ctx context.Context, req *adminpb.DeleteMessageRequest) (*adminpb.DeleteMessageResponse, error) {
+	e, err := h.record(ctx, "DeleteMessage", AuditTargetMessage, req.GetMessageId(), map[string]string{
+		"room_id": req.GetRoomId(),
+		"reason":  req.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = h.chat.DeleteMessage(ctx, req.GetRoomId(), req.GetMessageId())
+	h.finish(ctx, e, nil, err)
+	if err != nil {
+		if err == ErrMessageNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete message: %v", err)
+	}
+	return &adminpb.DeleteMessageResponse{}, nil
+}
+
+// RemoveRoomMember: 그룹 방에서 멤버를 내보냄 (1:1 방은 멤버가 고정이라 불가)
+func (h *AdminHandler) RemoveRoomMember(ctx context.Context, req *adminpb.RemoveRoomMemberRequest) (*adminpb.RemoveRoomMemberResponse, error) {
+	if req.GetUsername() == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is required")
+	}
+	room, err := h.chat.GetRoom(ctx, req.GetRoomId())
+	if err != nil {
+		if err == ErrRoomNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get room: %v", err)
+	}
+	if room.RoomType != RoomTypeGroup {
+		return nil, status.Error(codes.FailedPrecondition, "members can only be removed from group rooms")
+	}
+
+	e, err := h.record(ctx, "RemoveRoomMember", AuditTargetRoom, req.GetRoomId(), map[string]string{
+		"username": req.GetUsername(),
+		"reason":   req.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	removed, err := h.chat.RemoveRoomMember(ctx, req.GetRoomId(), req.GetUsername())
+	if err != nil {
+		h.finish(ctx, e, nil, err)
+		return nil, status.Errorf(codes.Internal, "failed to remove room member: %v", err)
+	}
+	if !removed {
+		err := status.Errorf(codes.NotFound, "user %s is not a member of the room", req.GetUsername())
+		h.finish(ctx, e, nil, err)
+		return nil, err
+	}
+	return &adminpb.RemoveRoomMemberResponse{}, nil
+}
+
+// ListAuditLog: 감사 기록 (최근순, page_token은 마지막 기록의 id)
+func (h *AdminHandler) ListAuditLog(ctx context.Context, req *adminpb.ListAuditLogRequest) (*adminpb.ListAuditLogResponse, error) {
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultAuditPageSize
+	}
+	if limit > maxAuditPageSize {
+		limit = maxAuditPageSize
+	}
+	var before int64
+	if req.GetPageToken() != "" {
+		var err error
+		if before, err = strconv.ParseInt(req.GetPageToken(), 10, 64); err != nil || before <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+	}
+
+	filter := AuditFilter{ActorID: req.GetActorId(), TargetID: req.GetTargetId()}
+	entries, err := h.audit.List(ctx, filter, before, limit+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit log: %v", err)
+	}
+	resp := &adminpb.ListAuditLogResponse{}
+	if len(entries) > limit {
+		entries = entries[:limit]
+		resp.NextPageToken = strconv.FormatInt(entries[limit-1].ID, 10)
+	}
+	for _, e := range entries {
+		detail, err := json.Marshal(e.Detail)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode audit detail: %v", err)
+		}
+		resp.Entries = append(resp.Entries, &adminpb.AuditEntry{
+			Id:            e.ID,
+			ActorId:       e.ActorID,
+			ActorUsername: e.ActorUsername,
+			ActorRole:     string(e.ActorRole),
+			Action:        e.Action,
+			TargetType:    e.TargetType,
+			TargetId:      e.TargetID,
+			Detail:        string(detail),
+			CreatedAt:     e.CreatedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
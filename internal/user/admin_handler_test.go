@@ -0,0 +1,202 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/adminpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeAdminService: 관리자 핸들러가 쓰는 메서드만 구현 (나머지는 nil 임베드라 부르면 panic)
+type fakeAdminService struct {
+	Service
+	users    map[string]*User
+	disabled []string // SetUserDisabled가 불린 유저 ID
+}
+
+func (f *fakeAdminService) GetProfile(ctx context.Context, userID string) (*User, error) {
+	if u, ok := f.users[userID]; ok {
+		return u, nil
+	}
+	return nil, ErrUserNotFound
+}
+
+func (f *fakeAdminService) SetUserDisabled(ctx context.Context, userID string, disabled bool) (*User, error) {
+	f.disabled = append(f.disabled, userID)
+	return f.users[userID], nil
+}
+
+// fakeAuditLog: 메모리에 쌓는 감사 기록 (err가 있으면 저장 실패)
+type fakeAuditLog struct {
+	AuditLog
+	entries []*AuditEntry
+	err     error
+}
+
+func (f *fakeAuditLog) Record(ctx context.Context, e *AuditEntry) error {
+	if f.err != nil {
+		return f.err
+	}
+	e.ID = int64(len(f.entries) + 1)
+	f.entries = append(f.entries, e)
+	return nil
+}
+
+func (f *fakeAuditLog) Annotate(ctx context.Context, id int64, detail map[string]string) error {
+	e := f.entries[id-1]
+	if e.Detail == nil {
+		e.Detail = map[string]string{}
+	}
+	for k, v := range detail {
+		e.Detail[k] = v
+	}
+	return nil
+}
+
+// fakeAdminChat: 방 하나("room")의 기록만 있는 ChatRepository (historyErr가 있으면 조회 실패)
+type fakeAdminChat struct {
+	ChatRepository
+	messages   []*MessageRecord
+	historyErr error
+}
+
+func (f *fakeAdminChat) GetRoom(ctx context.Context, roomID string) (*RoomInfoRecord, error) {
+	if roomID != "room" {
+		return nil, ErrRoomNotFound
+	}
+	return &RoomInfoRecord{RoomID: roomID, RoomType: RoomTypeGroup}, nil
+}
+
+func (f *fakeAdminChat) GetMessagesAfter(ctx context.Context, roomID string, cursor *MessageCursor, limit int) ([]*MessageRecord, error) {
+	if f.historyErr != nil {
+		return nil, f.historyErr
+	}
+	var out []*MessageRecord
+	for _, m := range f.messages {
+		if cursor == nil || m.SentAt.After(cursor.SentAt) {
+			out = append(out, m)
+		}
+	}
+	return out[:min(limit, len(out))], nil
+}
+
+// adminContext: 역할이 있는 유저로 인증된 context
+func adminContext(u *User) context.Context {
+	ctx := context.WithValue(context.Background(), userIDCtxKey, u.ID)
+	ctx = context.WithValue(ctx, usernameCtxKey, u.Username)
+	return context.WithValue(ctx, roleCtxKey, u.Role)
+}
+
+func TestAdminHandlerModerationRules(t *testing.T) {
+	const (
+		userID  = "00000000-0000-0000-0000-000000000001"
+		modID   = "00000000-0000-0000-0000-000000000002"
+		adminID = "00000000-0000-0000-0000-000000000003"
+	)
+	svc := &fakeAdminService{users: map[string]*User{
+		userID:  {ID: userID, Username: "alice", Role: RoleUser},
+		modID:   {ID: modID, Username: "mod", Role: RoleModerator},
+		adminID: {ID: adminID, Username: "root", Role: RoleAdmin},
+	}}
+	audit := &fakeAuditLog{}
+	h := NewAdminHandler(svc, nil, audit)
+
+	as := func(id string) context.Context { return adminContext(svc.users[id]) }
+	disable := func(ctx context.Context, target string) codes.Code {
+		_, err := h.SetUserDisabled(ctx, &adminpb.SetUserDisabledRequest{UserId: target, Disabled: true, Reason: "spam"})
+		return status.Code(err)
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		target string
+		want   codes.Code
+	}{
+		{"moderator suspends user", as(modID), userID, codes.OK},
+		{"admin suspends moderator", as(adminID), modID, codes.OK},
+		{"moderator cannot suspend self", as(modID), modID, codes.FailedPrecondition},
+		{"moderator cannot touch admin", as(modID), adminID, codes.PermissionDenied},
+		{"admin cannot suspend self", as(adminID), adminID, codes.FailedPrecondition},
+		{"unknown user", as(adminID), "00000000-0000-0000-0000-000000000009", codes.NotFound},
+	}
+	for _, tt := range tests {
+		if got := disable(tt.ctx, tt.target); got != tt.want {
+			t.Errorf("%s: code = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// 성공한 작업만 누가 했는지와 함께 기록됨
+	if len(audit.entries) != 2 {
+		t.Fatalf("audit entries = %d, want 2", len(audit.entries))
+	}
+	e := audit.entries[0]
+	if e.ActorID != modID || e.ActorRole != RoleModerator || e.Action != "SetUserDisabled" || e.TargetID != userID || e.Detail["reason"] != "spam" {
+		t.Errorf("audit entry = %+v", e)
+	}
+}
+
+// 감사 기록을 남기지 못하면 작업을 하지 않고 실패해야 함
+func TestAdminHandlerFailsClosedWithoutAuditLog(t *testing.T) {
+	const userID = "00000000-0000-0000-0000-000000000001"
+	admin := &User{ID: "00000000-0000-0000-0000-000000000003", Username: "root", Role: RoleAdmin}
+	svc := &fakeAdminService{users: map[string]*User{userID: {ID: userID, Username: "alice", Role: RoleUser}}}
+	audit := &fakeAuditLog{err: errors.New("injected: connection reset")}
+	h := NewAdminHandler(svc, &fakeAdminChat{}, audit)
+
+	if _, err := h.SetUserDisabled(adminContext(admin), &adminpb.SetUserDisabledRequest{UserId: userID, Disabled: true}); status.Code(err) != codes.Internal {
+		t.Errorf("SetUserDisabled err = %v, want Internal", err)
+	}
+	if len(svc.disabled) != 0 {
+		t.Errorf("user was disabled without an audit entry: %v", svc.disabled)
+	}
+	if _, err := h.GetRoomMessages(adminContext(admin), &adminpb.GetRoomMessagesRequest{RoomId: "room"}); status.Code(err) != codes.Internal {
+		t.Errorf("GetRoomMessages err = %v, want Internal", err)
+	}
+}
+
+// 방 기록은 cursor로 이어 읽는 페이지도 모두 기록되고, 실패한 조회는 실패 이유가 남아야 함
+func TestAdminHandlerAuditsEveryRoomMessagePage(t *testing.T) {
+	admin := &User{ID: "00000000-0000-0000-0000-000000000003", Username: "root", Role: RoleAdmin}
+	chat := &fakeAdminChat{}
+	for i := range 3 {
+		chat.messages = append(chat.messages, &MessageRecord{
+			ID:     fmt.Sprintf("00000000-0000-0000-0000-%012d", i+1),
+			RoomID: "room",
+			SentAt: time.Date(2024, 5, 1, 9, 0, i, 0, time.UTC),
+		})
+	}
+	audit := &fakeAuditLog{}
+	h := NewAdminHandler(&fakeAdminService{}, chat, audit)
+	ctx := adminContext(admin)
+
+	first, err := h.GetRoomMessages(ctx, &adminpb.GetRoomMessagesRequest{RoomId: "room", Limit: 2})
+	if err != nil || !first.HasMore {
+		t.Fatalf("first page = %v, %v", first, err)
+	}
+	if _, err := h.GetRoomMessages(ctx, &adminpb.GetRoomMessagesRequest{RoomId: "room", Limit: 2, Cursor: first.NextCursor}); err != nil {
+		t.Fatal(err)
+	}
+	chat.historyErr = errors.New("injected: connection reset")
+	if _, err := h.GetRoomMessages(ctx, &adminpb.GetRoomMessagesRequest{RoomId: "room"}); status.Code(err) != codes.Internal {
+		t.Fatalf("failed read: err = %v, want Internal", err)
+	}
+
+	if len(audit.entries) != 3 {
+		t.Fatalf("audit entries = %d, want one per call", len(audit.entries))
+	}
+	for i, want := range []string{"", first.NextCursor, ""} {
+		e := audit.entries[i]
+		if e.Action != "GetRoomMessages" || e.TargetID != "room" || e.ActorID != admin.ID || e.Detail["cursor"] != want {
+			t.Errorf("entry %d = %+v, want a read of room from cursor %q", i, e, want)
+		}
+	}
+	if audit.entries[1].Detail["error"] != "" || audit.entries[2].Detail["error"] == "" {
+		t.Errorf("error details = %q, %q; want only the failed read annotated", audit.entries[1].Detail["error"], audit.entries[2].Detail["error"])
+	}
+}
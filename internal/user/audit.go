@@ -0,0 +1,122 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// 감사 기록 대상 종류 (admin_audit_log.target_type)
+const (
+	AuditTargetUser    = "user"
+	AuditTargetRoom    = "room"
+	AuditTargetMessage = "message"
+)
+
+// AuditEntry: 관리자 작업 한 건 (admin_audit_log)
+type AuditEntry struct {
+	ID            int64
+	ActorID       string // 관리자 토큰으로 한 작업이면 빈 문자열
+	ActorUsername string
+	ActorRole     Role
+	Action        string
+	TargetType    string
+	TargetID      string
+	Detail        map[string]string
+	CreatedAt     time.Time
+}
+
+// AuditFilter: 기록 조회 조건 (빈 값은 조건 없음)
+type AuditFilter struct {
+	ActorID  string
+	TargetID string
+}
+
+// AuditLog: 관리자 작업 기록 저장소
+type AuditLog interface {
+	// Record: 기록을 남기고 e.ID / e.CreatedAt을 채움
+	Record(ctx context.Context, e *AuditEntry) error
+	// Annotate: 남긴 기록의 detail에 값을 더함 (작업 결과나 실패 이유)
+	Annotate(ctx context.Context, id int64, detail map[string]string) error
+	// List: 최근 기록부터 (beforeID가 0이 아니면 그보다 오래된 기록만)
+	List(ctx context.Context, filter AuditFilter, beforeID int64, limit int) ([]*AuditEntry, error)
+}
+
+type auditPostgresLog struct {
+	db *pgxpool.Pool
+}
+
+func NewAuditLog(db *pgxpool.Pool) AuditLog {
+	return &auditPostgresLog{db: db}
+}
+
+func (a *auditPostgresLog) Record(ctx context.Context, e *AuditEntry) error {
+	detail, err := json.Marshal(e.Detail)
+	if err != nil {
+		return err
+	}
+	if e.Detail == nil {
+		detail = []byte("{}")
+	}
+
+	var actorID *string
+	if e.ActorID != "" {
+		actorID = &e.ActorID
+	}
+
+	const q = `
+		INSERT INTO admin_audit_log (actor_id, actor_username, actor_role, action, target_type, target_id, detail)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+	return a.db.QueryRow(ctx, q, actorID, e.ActorUsername, string(e.ActorRole), e.Action, e.TargetType, e.TargetID, string(detail)).
+		Scan(&e.ID, &e.CreatedAt)
+}
+
+func (a *auditPostgresLog) Annotate(ctx context.Context, id int64, detail map[string]string) error {
+	extra, err := json.Marshal(detail)
+	if err != nil {
+		return err
+	}
+	_, err = a.db.Exec(ctx, `UPDATE admin_audit_log SET detail = detail || $2::jsonb WHERE id = $1`, id, string(extra))
+	return err
+}
+
+func (a *auditPostgresLog) List(ctx context.Context, filter AuditFilter, beforeID int64, limit int) ([]*AuditEntry, error) {
+	const q = `
+		SELECT id, actor_id, actor_username, actor_role, action, target_type, target_id, detail::text, created_at
+		FROM admin_audit_log
+		WHERE ($1 = '' OR actor_id::text = $1)
+		  AND ($2 = '' OR target_id = $2)
+		  AND ($3 = 0 OR id < $3)
+		ORDER BY id DESC
+		LIMIT $4
+	`
+	rows, err := a.db.Query(ctx, q, filter.ActorID, filter.TargetID, beforeID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []*AuditEntry{}
+	for rows.Next() {
+		var (
+			e       AuditEntry
+			actorID *string
+			detail  string
+		)
+		if err := rows.Scan(&e.ID, &actorID, &e.ActorUsername, &e.ActorRole, &e.Action, &e.TargetType, &e.TargetID, &detail, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		if actorID != nil {
+			e.ActorID = *actorID
+		}
+		if err := json.Unmarshal([]byte(detail), &e.Detail); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &e)
+	}
+	return entries, rows.Err()
+}
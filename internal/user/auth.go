@@ -2,11 +2,15 @@ package user
 
 import (
 	"context"
+	"crypto"
+	"crypto/subtle"
 	"errors"
+	"log"
 	"os"
 	"strings"
 	"time"
 
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
 	"github.com/golang-jwt/jwt/v5"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -17,31 +21,121 @@ import (
 // ===== JWT 관련 =====
 
 type Claims struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	SessionID string `json:"sid"`            // auth_sessions.id (로그아웃하면 이 세션의 토큰은 모두 거부됨)
+	Role      string `json:"role,omitempty"` // 역할이 생기기 전에 발급된 토큰에는 없음 (user로 취급)
 	jwt.RegisteredClaims
 }
 
+// 서명 키와 토큰 유효 기간 (ConfigureAuth 전에는 JWT_SECRET 환경 변수(HS256)와 기본값)
+var (
+	authSecret      string
+	authKeys        *KeySet // RS256 / EdDSA 키 (auth.signing_keys_dir / auth.jwks)
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 30 * 24 * time.Hour
+)
+
+// ConfigureAuth: 서버 시작 시 설정의 서명/검증 키와 토큰 유효 기간을 적용합니다.
+//   - signing_keys_dir: 키 디렉터리의 개인 키로 서명하고, 디렉터리의 모든 키로 검증 (usersvc)
+//   - jwks: 공개 키 문서(URL 또는 파일)로 검증만 함 (chatsvc, 게이트웨이 등)
+//   - jwt_secret: 예전 HS256 토큰. 키 디렉터리가 없으면 이걸로 서명하고, 있으면 교체 기간 동안 검증에만 씀
+func ConfigureAuth(cfg config.AuthConfig) error {
+	authSecret = cfg.JWTSecret
+	if cfg.AccessTokenTTL > 0 {
+		accessTokenTTL = cfg.AccessTokenTTL
+	}
+	if cfg.RefreshTokenTTL > 0 {
+		refreshTokenTTL = cfg.RefreshTokenTTL
+	}
+
+	authKeys = nil
+	if cfg.SigningKeysDir == "" && cfg.JWKS == "" {
+		return nil
+	}
+
+	ks := &KeySet{keys: make(map[string]crypto.PublicKey)}
+	if cfg.SigningKeysDir != "" {
+		var err error
+		if ks, err = LoadKeyDir(cfg.SigningKeysDir, cfg.SigningKeyID); err != nil {
+			return err
+		}
+		log.Printf("JWT 서명 키: %s (%s), 검증 키 %d개", ks.signing.kid, ks.signing.method.Alg(), len(ks.keys))
+	}
+	if cfg.JWKS != "" {
+		ks.remote = newRemoteJWKS(cfg.JWKS, cfg.JWKSRefreshInterval)
+		// usersvc가 아직 안 떠 있을 수 있으므로 첫 요청 때 다시 시도함
+		if err := ks.remote.refresh(); err != nil {
+			log.Printf("JWKS를 가져오지 못했습니다 (요청 시 다시 시도): %v", err)
+		}
+	}
+	authKeys = ks
+	return nil
+}
+
+// SessionChecker: access token의 세션이 아직 살아 있는지 확인 (로그아웃/탈취 감지로 끊긴 세션 거부용)
+type SessionChecker interface {
+	SessionActive(ctx context.Context, sessionID string) (bool, error)
+}
+
+var sessionChecker SessionChecker
+
+// ConfigureSessions: 인터셉터가 토큰마다 세션 상태를 확인하도록 설정합니다.
+// 설정하지 않으면 서명과 만료만 확인합니다.
+func ConfigureSessions(c SessionChecker) {
+	sessionChecker = c
+}
+
+// 관리자 API (AdminService): moderator/admin 역할의 access token, 또는 x-admin-token metadata의 관리자 토큰으로 인증
+// 관리자 토큰은 첫 관리자를 정할 때나 자동화용 (admin 역할로 취급하고, 감사 기록에는 AdminTokenActor로 남음)
+const (
+	adminServicePrefix = "/admin.v1.AdminService/"
+	AdminTokenHeader   = "x-admin-token"
+	AdminTokenActor    = "admin-token"
+)
+
+var adminToken string
+
+// ConfigureAdmin: 관리자 토큰(admin.token)을 설정합니다. 비어 있으면 관리자 토큰으로는 인증할 수 없습니다.
+func ConfigureAdmin(token string) {
+	adminToken = token
+}
+
+// authenticateAdmin: 관리자 토큰 확인 (길이 외의 정보가 새지 않도록 상수 시간 비교)
+func authenticateAdmin(ctx context.Context, token string) (context.Context, error) {
+	if adminToken == "" {
+		return nil, status.Error(codes.PermissionDenied, "admin token is disabled")
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "invalid admin token")
+	}
+	ctx = context.WithValue(ctx, usernameCtxKey, AdminTokenActor)
+	ctx = context.WithValue(ctx, roleCtxKey, RoleAdmin)
+	return ctx, nil
+}
+
 func jwtSecret() ([]byte, error) {
-	secret := os.Getenv("JWT_SECRET")
+	secret := authSecret
+	if secret == "" && authKeys == nil {
+		secret = os.Getenv("JWT_SECRET")
+	}
 	if secret == "" {
 		return nil, errors.New("JWT_SECRET is not set")
 	}
 	return []byte(secret), nil
 }
 
-func GenerateAccessToken(u *User) (string, error) {
-	secret, err := jwtSecret()
-	if err != nil {
-		return "", err
-	}
-
+// GenerateAccessToken: sessionID 세션에 묶인 access token 발급
+// 서명 키가 있으면 RS256/EdDSA + kid 헤더, 없으면 HS256
+func GenerateAccessToken(u *User, sessionID string) (string, error) {
 	claims := Claims{
-		UserID:   u.ID,
-		Username: u.Username,
+		UserID:    u.ID,
+		Username:  u.Username,
+		SessionID: sessionID,
+		Role:      string(u.Role),
 		RegisteredClaims: jwt.RegisteredClaims{
-			// 만료 시간: 24시간 (원하면 줄여도 됨)
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+			// 만료 시간: auth.access_token_ttl (기본 15분, 이후에는 refresh token으로 갱신)
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(accessTokenTTL)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
 			Issuer:    "gdg-chat-app",
@@ -49,24 +143,51 @@ func GenerateAccessToken(u *User) (string, error) {
 		},
 	}
 
+	if authKeys != nil && authKeys.signing != nil {
+		token := jwt.NewWithClaims(authKeys.signing.method, claims)
+		token.Header["kid"] = authKeys.signing.kid
+		return token.SignedString(authKeys.signing.key)
+	}
+
+	secret, err := jwtSecret()
+	if err != nil {
+		return "", err
+	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString(secret)
 }
 
-func ParseAndValidateToken(tokenStr string) (*Claims, error) {
-	secret, err := jwtSecret()
+// verificationKey: 토큰의 alg / kid에 맞는 검증 키
+// 공개 키로 HS256을 검증하는 alg 혼동 공격을 막기 위해 키 종류와 alg가 맞아야 함
+func verificationKey(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		return jwtSecret()
+	}
+
+	if authKeys == nil {
+		return nil, errors.New("unexpected signing method")
+	}
+	kid, _ := token.Header["kid"].(string)
+	pub, err := authKeys.publicKey(kid)
 	if err != nil {
 		return nil, err
 	}
+	method, err := signingMethodFor(pub)
+	if err != nil || method.Alg() != token.Method.Alg() {
+		return nil, errors.New("signing method does not match key")
+	}
+	return pub, nil
+}
 
+func ParseAndValidateToken(tokenStr string) (*Claims, error) {
 	claims := &Claims{}
-	token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
-		// HS256만 허용
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return secret, nil
-	})
+	token, err := jwt.ParseWithClaims(tokenStr, claims, verificationKey,
+		jwt.WithValidMethods([]string{
+			jwt.SigningMethodRS256.Alg(),
+			jwt.SigningMethodEdDSA.Alg(),
+			jwt.SigningMethodHS256.Alg(),
+		}),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +202,12 @@ func ParseAndValidateToken(tokenStr string) (*Claims, error) {
 // context key
 type ctxKey string
 
-const userIDCtxKey ctxKey = "userID"
+const (
+	userIDCtxKey    ctxKey = "userID"
+	usernameCtxKey  ctxKey = "username"
+	sessionIDCtxKey ctxKey = "sessionID"
+	roleCtxKey      ctxKey = "role"
+)
 
 func UserIDFromContext(ctx context.Context) (string, bool) {
 	val := ctx.Value(userIDCtxKey)
@@ -92,28 +218,126 @@ func UserIDFromContext(ctx context.Context) (string, bool) {
 	return id, ok
 }
 
-// 인증이 필요 없는 메서드들 (회원가입/로그인/중복체크/전화인증)
+// UsernameFromContext: 토큰에 담긴 username(로그인 아이디)을 꺼냄
+func UsernameFromContext(ctx context.Context) (string, bool) {
+	val := ctx.Value(usernameCtxKey)
+	if val == nil {
+		return "", false
+	}
+	name, ok := val.(string)
+	return name, ok
+}
+
+// CheckSession: 인증된 context의 세션이 아직 살아 있는지 다시 확인
+// 스트림처럼 오래 열려 있는 호출이 도중에 끊긴 세션(로그아웃/차단/강제 로그아웃)을 알아채는 데 사용
+// 끊겼으면 Unauthenticated, 확인하지 못하면 Unavailable (ConfigureSessions를 하지 않았으면 항상 nil)
+func CheckSession(ctx context.Context) error {
+	sessionID, _ := SessionIDFromContext(ctx)
+	return checkSession(ctx, sessionID)
+}
+
+func checkSession(ctx context.Context, sessionID string) error {
+	if sessionChecker == nil {
+		return nil
+	}
+	if sessionID == "" {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+	active, err := sessionChecker.SessionActive(ctx, sessionID)
+	if err != nil {
+		return status.Error(codes.Unavailable, "failed to check session")
+	}
+	if !active {
+		return status.Error(codes.Unauthenticated, "session revoked")
+	}
+	return nil
+}
+
+// SessionIDFromContext: 토큰의 세션 ID(sid)를 꺼냄
+func SessionIDFromContext(ctx context.Context) (string, bool) {
+	val := ctx.Value(sessionIDCtxKey)
+	if val == nil {
+		return "", false
+	}
+	id, ok := val.(string)
+	return id, ok
+}
+
+// RoleFromContext: 인증된 요청의 역할 (인증 전이거나 역할 클레임이 없으면 user)
+func RoleFromContext(ctx context.Context) Role {
+	if role, ok := ctx.Value(roleCtxKey).(Role); ok {
+		return role
+	}
+	return RoleUser
+}
+
+// 인증이 필요 없는 메서드들 (회원가입/로그인/중복체크/전화인증/토큰 갱신)
 var publicMethods = map[string]bool{
 	"/user.v1.UserService/SignUp":                   true,
 	"/user.v1.UserService/Login":                    true,
+	"/user.v1.UserService/SocialLogin":              true,
+	"/user.v1.UserService/RefreshToken":             true,
 	"/user.v1.UserService/CheckUsername":            true,
 	"/user.v1.UserService/CheckEmail":               true,
 	"/user.v1.UserService/RequestPhoneVerification": true,
 	"/user.v1.UserService/VerifyPhone":              true,
 }
 
-// UnaryAuthInterceptor: 토큰 검사 + userID를 context에 넣어줌
-func UnaryAuthInterceptor(
-	ctx context.Context,
-	req interface{},
-	info *grpc.UnaryServerInfo,
-	handler grpc.UnaryHandler,
-) (interface{}, error) {
-	// public 메서드는 그냥 통과
-	if publicMethods[info.FullMethod] {
-		return handler(ctx, req)
+// 메서드별로 필요한 최소 역할 (여기 없는 메서드는 로그인만 하면 됨)
+// AdminService 메서드가 여기 빠져 있으면 admin만 부를 수 있음 (requiredRole)
+var methodRoles = map[string]Role{
+	adminServicePrefix + "ListUsers":        RoleModerator,
+	adminServicePrefix + "GetUser":          RoleModerator,
+	adminServicePrefix + "SetUserDisabled":  RoleModerator,
+	adminServicePrefix + "ForceLogout":      RoleModerator,
+	adminServicePrefix + "ListUserRooms":    RoleModerator,
+	adminServicePrefix + "GetRoomMessages":  RoleModerator,
+	adminServicePrefix + "DeleteMessage":    RoleModerator,
+	adminServicePrefix + "RemoveRoomMember": RoleModerator,
+	adminServicePrefix + "DeleteUser":       RoleAdmin,
+	adminServicePrefix + "ResetPassword":    RoleAdmin,
+	adminServicePrefix + "SetUserRole":      RoleAdmin,
+	adminServicePrefix + "ListAuditLog":     RoleAdmin,
+}
+
+func requiredRole(fullMethod string) Role {
+	if role, ok := methodRoles[fullMethod]; ok {
+		return role
+	}
+	if strings.HasPrefix(fullMethod, adminServicePrefix) {
+		return RoleAdmin
+	}
+	return RoleUser
+}
+
+// authorize: 메서드 정책에 따라 인증하고 인증된 context를 돌려줌
+//   - public 메서드: 그대로 통과
+//   - AdminService에 관리자 토큰이 붙어 있으면: 관리자 토큰으로 인증 (admin 역할)
+//   - 그 외: access token을 검증하고 역할이 메서드에 필요한 역할 이상인지 확인
+func authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	if publicMethods[fullMethod] {
+		return ctx, nil
+	}
+
+	if strings.HasPrefix(fullMethod, adminServicePrefix) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if values := md.Get(AdminTokenHeader); len(values) > 0 {
+			return authenticateAdmin(ctx, values[0])
+		}
+	}
+
+	ctx, err := authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if need := requiredRole(fullMethod); !RoleFromContext(ctx).AtLeast(need) {
+		return nil, status.Errorf(codes.PermissionDenied, "requires %s role", need)
 	}
+	return ctx, nil
+}
 
+// authenticate: metadata의 Authorization 헤더를 검증하고 유저 정보를 context에 넣어줌
+func authenticate(ctx context.Context) (context.Context, error) {
 	// metadata에서 Authorization 헤더 꺼내기
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -141,9 +365,64 @@ func UnaryAuthInterceptor(
 		return nil, status.Error(codes.Unauthenticated, "invalid token")
 	}
 
-	// userID를 context에 넣어서 핸들러에서 꺼내 쓰게 하기
+	// 로그아웃했거나 탈취 감지로 끊긴 세션의 토큰은 만료 전이라도 거부
+	if err := checkSession(ctx, claims.SessionID); err != nil {
+		return nil, err
+	}
+
+	// userID / username / 세션 ID를 context에 넣어서 핸들러에서 꺼내 쓰게 하기
 	ctx = context.WithValue(ctx, userIDCtxKey, claims.UserID)
+	ctx = context.WithValue(ctx, usernameCtxKey, claims.Username)
+	ctx = context.WithValue(ctx, sessionIDCtxKey, claims.SessionID)
+	if claims.Role != "" {
+		ctx = context.WithValue(ctx, roleCtxKey, Role(claims.Role))
+	}
+	return ctx, nil
+}
+
+// UnaryAuthInterceptor: 토큰 검사 + userID를 context에 넣어줌
+func UnaryAuthInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	// 토큰 검사 + 메서드별 역할 확인 (public 메서드는 그냥 통과)
+	ctx, err := authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
 
 	// 다음 핸들러 호출
 	return handler(ctx, req)
 }
+
+// authedStream: 인증된 context를 돌려주도록 ServerStream을 감싼 타입
+type authedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authedStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamAuthInterceptor: 스트리밍 RPC(JoinChat 등)용 토큰 검사
+// UnaryAuthInterceptor와 같은 토큰/같은 context key를 사용함
+func StreamAuthInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if publicMethods[info.FullMethod] {
+		return handler(srv, ss)
+	}
+
+	ctx, err := authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authedStream{ServerStream: ss, ctx: ctx})
+}
@@ -0,0 +1,180 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeSessions: 세션 ID → 살아 있는지 (err가 있으면 DB 장애 흉내)
+type fakeSessions struct {
+	active map[string]bool
+	err    error
+}
+
+func (f fakeSessions) SessionActive(ctx context.Context, sessionID string) (bool, error) {
+	return f.active[sessionID], f.err
+}
+
+func withToken(t *testing.T, u *User, sessionID string) context.Context {
+	t.Helper()
+	token, err := GenerateAccessToken(u, sessionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestAuthenticateHonorsSessionRevocation(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+	t.Cleanup(func() { ConfigureSessions(nil) })
+	u := &User{ID: "u1", Username: "alice"}
+
+	ConfigureSessions(fakeSessions{active: map[string]bool{"live": true}})
+
+	ctx, err := authenticate(withToken(t, u, "live"))
+	if err != nil {
+		t.Fatalf("active session rejected: %v", err)
+	}
+	if sid, _ := SessionIDFromContext(ctx); sid != "live" {
+		t.Errorf("session ID in context = %q, want live", sid)
+	}
+
+	for name, sessionID := range map[string]string{"revoked": "gone", "no sid": ""} {
+		if _, err := authenticate(withToken(t, u, sessionID)); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("%s: err = %v, want Unauthenticated", name, err)
+		}
+	}
+
+	ConfigureSessions(fakeSessions{err: errors.New("db down")})
+	if _, err := authenticate(withToken(t, u, "live")); status.Code(err) != codes.Unavailable {
+		t.Errorf("checker failure: err = %v, want Unavailable", err)
+	}
+}
+
+// 인증 후에 끊긴 세션은 CheckSession으로 알 수 있어야 함 (열려 있는 스트림용)
+func TestCheckSessionAfterRevocation(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+	t.Cleanup(func() { ConfigureSessions(nil) })
+	u := &User{ID: "u1", Username: "alice"}
+
+	ConfigureSessions(fakeSessions{active: map[string]bool{"live": true}})
+	ctx, err := authenticate(withToken(t, u, "live"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckSession(ctx); err != nil {
+		t.Fatalf("CheckSession on a live session: %v", err)
+	}
+
+	ConfigureSessions(fakeSessions{active: map[string]bool{}})
+	if err := CheckSession(ctx); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("revoked: err = %v, want Unauthenticated", err)
+	}
+	ConfigureSessions(fakeSessions{err: errors.New("db down")})
+	if err := CheckSession(ctx); status.Code(err) != codes.Unavailable {
+		t.Errorf("checker failure: err = %v, want Unavailable", err)
+	}
+	ConfigureSessions(nil)
+	if err := CheckSession(context.Background()); err != nil {
+		t.Errorf("without a checker: err = %v, want nil", err)
+	}
+}
+
+func TestRefreshTokenHash(t *testing.T) {
+	raw, hash, err := newRefreshToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if raw == hash || hashRefreshToken(raw) != hash {
+		t.Fatalf("hash mismatch: raw %q hash %q", raw, hash)
+	}
+	other, _, _ := newRefreshToken()
+	if other == raw {
+		t.Fatal("refresh tokens must be random")
+	}
+}
+
+func TestAdminTokenAuthentication(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+	t.Cleanup(func() { ConfigureAdmin("") })
+	info := &grpc.UnaryServerInfo{FullMethod: "/admin.v1.AdminService/ListUsers"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	call := func(ctx context.Context) error {
+		_, err := UnaryAuthInterceptor(ctx, nil, info, handler)
+		return err
+	}
+	withAdminToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(AdminTokenHeader, token))
+	}
+
+	// 토큰을 설정하지 않으면 관리자 토큰으로는 인증할 수 없음
+	if err := call(withAdminToken("")); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("admin token without configured token: err = %v, want PermissionDenied", err)
+	}
+
+	ConfigureAdmin("0123456789abcdef")
+	var got context.Context
+	_, err := UnaryAuthInterceptor(withAdminToken("0123456789abcdef"), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = ctx
+		return "ok", nil
+	})
+	if err != nil {
+		t.Fatalf("valid admin token rejected: %v", err)
+	}
+	if name, _ := UsernameFromContext(got); name != AdminTokenActor || RoleFromContext(got) != RoleAdmin {
+		t.Errorf("admin token context = %q / %q, want %q / admin", name, RoleFromContext(got), AdminTokenActor)
+	}
+	for name, ctx := range map[string]context.Context{
+		"wrong token":    withAdminToken("0123456789abcdeX"),
+		"no metadata":    context.Background(),
+		"prefix of real": withAdminToken("0123456789"),
+	} {
+		if err := call(ctx); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("%s: err = %v, want Unauthenticated", name, err)
+		}
+	}
+}
+
+func TestMethodRolePolicy(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	call := func(ctx context.Context, method string) error {
+		_, err := UnaryAuthInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	// 역할 클레임이 없는 토큰(역할 도입 전 발급)은 user로 취급
+	plain := withToken(t, &User{ID: "u1", Username: "alice"}, "")
+	user := withToken(t, &User{ID: "u1", Username: "alice", Role: RoleUser}, "")
+	moderator := withToken(t, &User{ID: "u2", Username: "mod", Role: RoleModerator}, "")
+	admin := withToken(t, &User{ID: "u3", Username: "root", Role: RoleAdmin}, "")
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		want   codes.Code
+	}{
+		{"user calls user api", user, "/user.v1.UserService/GetProfile", codes.OK},
+		{"no role claim", plain, adminServicePrefix + "ListUsers", codes.PermissionDenied},
+		{"user calls admin api", user, adminServicePrefix + "ListUsers", codes.PermissionDenied},
+		{"moderator lists users", moderator, adminServicePrefix + "ListUsers", codes.OK},
+		{"moderator suspends", moderator, adminServicePrefix + "SetUserDisabled", codes.OK},
+		{"moderator deletes user", moderator, adminServicePrefix + "DeleteUser", codes.PermissionDenied},
+		{"moderator changes role", moderator, adminServicePrefix + "SetUserRole", codes.PermissionDenied},
+		{"admin changes role", admin, adminServicePrefix + "SetUserRole", codes.OK},
+		{"unlisted admin method", moderator, adminServicePrefix + "NewMethod", codes.PermissionDenied},
+		{"unlisted admin method as admin", admin, adminServicePrefix + "NewMethod", codes.OK},
+	}
+	for _, tt := range tests {
+		if err := call(tt.ctx, tt.method); status.Code(err) != tt.want {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package user
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MessageCursor는 메시지 목록에서의 위치입니다. (sent_at, id) 순서로 정렬됩니다.
+type MessageCursor struct {
+	SentAt time.Time
+	ID     string
+}
+
+// RoomCursor는 방 목록(최근 활동순)에서의 위치입니다. (last_activity_at, room_id) 순서로 정렬됩니다.
+type RoomCursor struct {
+	LastActivityAt time.Time
+	RoomID         string
+}
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// CursorOf: 메시지 레코드의 위치를 cursor로 만듭니다.
+func CursorOf(r *MessageRecord) *MessageCursor {
+	return &MessageCursor{SentAt: r.SentAt, ID: r.ID}
+}
+
+// RoomCursorOf: 방 레코드의 위치를 cursor로 만듭니다.
+func RoomCursorOf(r *RoomInfoRecord) *RoomCursor {
+	return &RoomCursor{LastActivityAt: r.LastActivityAt, RoomID: r.RoomID}
+}
+
+// Encode: 클라이언트에 내려줄 불투명(opaque) 문자열로 인코딩합니다.
+func (c *MessageCursor) Encode() string {
+	return encodeTimeID(c.SentAt, c.ID)
+}
+
+// DecodeMessageCursor: Encode로 만든 문자열을 다시 cursor로 바꿉니다.
+func DecodeMessageCursor(s string) (*MessageCursor, error) {
+	t, id, err := decodeTimeID(s)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageCursor{SentAt: t, ID: id}, nil
+}
+
+// Encode: 방 목록 page token으로 인코딩합니다.
+func (c *RoomCursor) Encode() string {
+	return encodeTimeID(c.LastActivityAt, c.RoomID)
+}
+
+// DecodeRoomCursor: Encode로 만든 page token을 다시 cursor로 바꿉니다.
+func DecodeRoomCursor(s string) (*RoomCursor, error) {
+	t, id, err := decodeTimeID(s)
+	if err != nil {
+		return nil, err
+	}
+	return &RoomCursor{LastActivityAt: t, RoomID: id}, nil
+}
+
+// encodeTimeID: "<unix micro>:<id>"의 base64(URL-safe). 클라이언트는 내용을 해석하면 안 됩니다.
+func encodeTimeID(t time.Time, id string) string {
+	raw := strconv.FormatInt(t.UnixMicro(), 10) + ":" + id
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeTimeID: encodeTimeID의 반대. id는 메시지/방 ID이므로 UUID 형식이 아니면 잘못된 cursor로 취급합니다.
+// (쿼리의 $n::uuid 변환에서 실패해 Internal 에러가 나지 않도록)
+func decodeTimeID(s string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return time.Time{}, "", ErrInvalidCursor
+	}
+
+	micros, id, ok := strings.Cut(string(raw), ":")
+	if !ok || !uuidPattern.MatchString(id) {
+		return time.Time{}, "", ErrInvalidCursor
+	}
+	us, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return time.Time{}, "", ErrInvalidCursor
+	}
+
+	return time.UnixMicro(us), id, nil
+}
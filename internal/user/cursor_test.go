@@ -0,0 +1,49 @@
+package user
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMessageCursorRoundTrip(t *testing.T) {
+	want := &MessageCursor{SentAt: time.Date(2024, 5, 1, 9, 0, 0, 123456000, time.UTC), ID: "3f8e2a4c-1b7d-4e9a-8c2f-5d6e7f8a9b0c"}
+	got, err := DecodeMessageCursor(want.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.SentAt.Equal(want.SentAt) || got.ID != want.ID {
+		t.Fatalf("DecodeMessageCursor(Encode()) = %+v, want %+v", got, want)
+	}
+
+	room := &RoomCursor{LastActivityAt: want.SentAt, RoomID: want.ID}
+	gotRoom, err := DecodeRoomCursor(room.Encode())
+	if err != nil || !gotRoom.LastActivityAt.Equal(room.LastActivityAt) || gotRoom.RoomID != room.RoomID {
+		t.Fatalf("DecodeRoomCursor(Encode()) = %+v, %v; want %+v", gotRoom, err, room)
+	}
+}
+
+func TestDecodeCursorRejectsMalformed(t *testing.T) {
+	raw := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+	const id = "3f8e2a4c-1b7d-4e9a-8c2f-5d6e7f8a9b0c"
+	tests := []struct {
+		name, cursor string
+	}{
+		{"not base64", "%%%"},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte("1714554000000000:" + id))},
+		{"no separator", raw("1714554000000000")},
+		{"empty id", raw("1714554000000000:")},
+		{"non-numeric time", raw("yesterday:" + id)},
+		{"non-UUID id", raw("1714554000000000:m001")},
+		{"id with trailing text", raw("1714554000000000:" + id + "' OR 1=1")},
+	}
+	for _, tt := range tests {
+		if _, err := DecodeMessageCursor(tt.cursor); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("DecodeMessageCursor(%s) err = %v, want ErrInvalidCursor", tt.name, err)
+		}
+		if _, err := DecodeRoomCursor(tt.cursor); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("DecodeRoomCursor(%s) err = %v, want ErrInvalidCursor", tt.name, err)
+		}
+	}
+}
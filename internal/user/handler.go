@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Dorazi23/gRPC_Chat_Project/pkg/userpb"
 	"google.golang.org/grpc/codes"
@@ -85,8 +86,18 @@ func (h *Handler) CheckEmail(ctx context.Context, req *userpb.CheckEmailRequest)
 
 func (h *Handler) RequestPhoneVerification(ctx context.Context, req *userpb.RequestPhoneVerificationRequest) (*userpb.RequestPhoneVerificationResponse, error) {
 	verificationID, err := h.svc.RequestPhoneVerification(ctx, req.GetPhone())
+	if errors.Is(err, ErrSMSNotConfigured) {
+		return nil, status.Error(codes.FailedPrecondition, "phone verification is not available on this server")
+	}
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to request phone verification: %v", err)
+		switch err {
+		case ErrInvalidPhone:
+			return nil, status.Error(codes.InvalidArgument, "invalid phone number")
+		case ErrVerificationInProgress:
+			return nil, status.Error(codes.ResourceExhausted, "verification code was sent recently, try again later")
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to request phone verification: %v", err)
+		}
 	}
 	return &userpb.RequestPhoneVerificationResponse{
 		VerificationId: verificationID,
@@ -96,11 +107,24 @@ func (h *Handler) RequestPhoneVerification(ctx context.Context, req *userpb.Requ
 func (h *Handler) VerifyPhone(ctx context.Context, req *userpb.VerifyPhoneRequest) (*userpb.VerifyPhoneResponse, error) {
 	ok, err := h.svc.VerifyPhone(ctx, req.GetVerificationId(), req.GetCode())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to verify phone: %v", err)
+		switch err {
+		case ErrVerificationNotFound:
+			return nil, status.Error(codes.NotFound, "verification not found")
+		case ErrVerificationExpired:
+			return nil, status.Error(codes.FailedPrecondition, "verification code expired, request a new one")
+		case ErrTooManyAttempts:
+			return nil, status.Error(codes.ResourceExhausted, "too many attempts, request a new code")
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to verify phone: %v", err)
+		}
 	}
-	return &userpb.VerifyPhoneResponse{
+	resp := &userpb.VerifyPhoneResponse{
 		Success: ok,
-	}, nil
+	}
+	if ok {
+		resp.VerificationId = req.GetVerificationId()
+	}
+	return resp, nil
 }
 
 // 회원가입/로그인
@@ -117,9 +141,12 @@ func (h *Handler) SignUp(ctx context.Context, req *userpb.SignUpRequest) (*userp
 		req.GetPhone(),
 		req.GetEmail(),
 		req.GetPassword(),
+		req.GetPhoneVerificationId(),
 	)
 	if err != nil {
 		switch err {
+		case ErrVerificationNotUsable:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
 		case ErrUsernameTaken:
 			return nil, status.Error(codes.AlreadyExists, "username already taken")
 		case ErrEmailTaken:
@@ -136,20 +163,22 @@ func (h *Handler) SignUp(ctx context.Context, req *userpb.SignUpRequest) (*userp
 
 func (h *Handler) Login(ctx context.Context, req *userpb.LoginRequest) (*userpb.LoginResponse, error) {
 	u, err := h.svc.Login(ctx, req.GetUsername(), req.GetPassword())
+	if err == ErrUserDisabled {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to login: %v", err)
 	}
 
-	// JWT 토큰 실제로 발급하기
-	accessToken, err := GenerateAccessToken(u)
+	// 새 세션을 만들고 access / refresh token 발급
+	tokens, err := h.svc.IssueTokens(ctx, u)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
 	}
 
-	// refresh_token은 나중에 구현, 지금은 빈 문자열로 두자
 	return &userpb.LoginResponse{
-		AccessToken:  accessToken,
-		RefreshToken: "",
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 		User:         toProtoUser(u),
 	}, nil
 }
@@ -157,17 +186,153 @@ func (h *Handler) Login(ctx context.Context, req *userpb.LoginRequest) (*userpb.
 func (h *Handler) SocialLogin(ctx context.Context, req *userpb.SocialLoginRequest) (*userpb.SocialLoginResponse, error) {
 	u, err := h.svc.SocialLogin(ctx, req.GetProvider(), req.GetAccessToken())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to social login: %v", err)
+		return nil, socialError("failed to social login", err)
+	}
+
+	tokens, err := h.svc.IssueTokens(ctx, u)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
 	}
 
-	// TODO: 소셜 로그인용 토큰 생성
 	return &userpb.SocialLoginResponse{
-		AccessToken:  "dummy-access-token",
-		RefreshToken: "dummy-refresh-token",
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 		User:         toProtoUser(u),
 	}, nil
 }
 
+// socialError: 소셜 로그인/연결 에러 → gRPC status
+func socialError(msg string, err error) error {
+	switch err {
+	case ErrUnsupportedProvider:
+		return status.Error(codes.InvalidArgument, "unsupported social provider")
+	case ErrInvalidSocialToken:
+		return status.Error(codes.Unauthenticated, "invalid social access token")
+	case ErrSocialEmailTaken, ErrSocialIdentityTaken, ErrProviderAlreadyLinked:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case ErrIdentityNotLinked:
+		return status.Error(codes.NotFound, err.Error())
+	case ErrLastLoginMethod:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case ErrUserDisabled:
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
+
+// 도메인 Identity 목록 → proto 변환
+func toProtoIdentities(ids []*Identity) []*userpb.SocialIdentity {
+	out := make([]*userpb.SocialIdentity, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, &userpb.SocialIdentity{
+			Provider: id.Provider,
+			Email:    id.Email,
+			LinkedAt: id.CreatedAt.Unix(),
+		})
+	}
+	return out
+}
+
+// 소셜 계정 연결
+
+func (h *Handler) LinkSocialAccount(ctx context.Context, req *userpb.LinkSocialAccountRequest) (*userpb.LinkSocialAccountResponse, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "no user in context")
+	}
+
+	ids, err := h.svc.LinkSocialAccount(ctx, userID, req.GetProvider(), req.GetAccessToken())
+	if err != nil {
+		return nil, socialError("failed to link social account", err)
+	}
+	return &userpb.LinkSocialAccountResponse{
+		Identities: toProtoIdentities(ids),
+	}, nil
+}
+
+func (h *Handler) UnlinkSocialAccount(ctx context.Context, req *userpb.UnlinkSocialAccountRequest) (*userpb.UnlinkSocialAccountResponse, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "no user in context")
+	}
+
+	ids, err := h.svc.UnlinkSocialAccount(ctx, userID, req.GetProvider())
+	if err != nil {
+		return nil, socialError("failed to unlink social account", err)
+	}
+	return &userpb.UnlinkSocialAccountResponse{
+		Identities: toProtoIdentities(ids),
+	}, nil
+}
+
+func (h *Handler) ListSocialAccounts(ctx context.Context, req *userpb.ListSocialAccountsRequest) (*userpb.ListSocialAccountsResponse, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "no user in context")
+	}
+
+	ids, err := h.svc.ListSocialAccounts(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list social accounts: %v", err)
+	}
+	return &userpb.ListSocialAccountsResponse{
+		Identities: toProtoIdentities(ids),
+	}, nil
+}
+
+// 토큰 갱신/로그아웃
+
+func (h *Handler) RefreshToken(ctx context.Context, req *userpb.RefreshTokenRequest) (*userpb.RefreshTokenResponse, error) {
+	tokens, err := h.svc.RefreshToken(ctx, req.GetRefreshToken())
+	if err != nil {
+		switch err {
+		case ErrInvalidRefreshToken:
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired refresh token")
+		case ErrRefreshTokenReused:
+			return nil, status.Error(codes.Unauthenticated, "refresh token reuse detected, session revoked")
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to refresh token: %v", err)
+		}
+	}
+
+	return &userpb.RefreshTokenResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+func (h *Handler) Logout(ctx context.Context, req *userpb.LogoutRequest) (*userpb.LogoutResponse, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "no user in context")
+	}
+	sessionID, ok := SessionIDFromContext(ctx)
+	if !ok || sessionID == "" {
+		return nil, status.Error(codes.Unauthenticated, "no session in token")
+	}
+
+	if err := h.svc.Logout(ctx, userID, sessionID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to logout: %v", err)
+	}
+	return &userpb.LogoutResponse{}, nil
+}
+
+func (h *Handler) LogoutAllDevices(ctx context.Context, req *userpb.LogoutAllDevicesRequest) (*userpb.LogoutAllDevicesResponse, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "no user in context")
+	}
+
+	n, err := h.svc.LogoutAllDevices(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to logout all devices: %v", err)
+	}
+	return &userpb.LogoutAllDevicesResponse{
+		RevokedSessions: int32(n),
+	}, nil
+}
+
 // 프로필
 
 func (h *Handler) GetProfile(ctx context.Context, req *userpb.GetProfileRequest) (*userpb.GetProfileResponse, error) {
@@ -199,9 +364,17 @@ func (h *Handler) UpdateProfile(ctx context.Context, req *userpb.UpdateProfileRe
 		req.GetNickname(),
 		req.GetPhone(),
 		req.GetEmail(),
+		req.GetPhoneVerificationId(),
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update profile: %v", err)
+		switch err {
+		case ErrVerificationNotUsable:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case ErrUserNotFound:
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to update profile: %v", err)
+		}
 	}
 
 	return &userpb.UpdateProfileResponse{
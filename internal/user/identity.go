@@ -0,0 +1,289 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/userpb"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+	// ErrSocialEmailTaken: 소셜 계정의 (인증된) 이메일로 이미 가입한 유저가 있음 → 로그인 후 LinkSocialAccount로 연결해야 함
+	ErrSocialEmailTaken      = errors.New("email already registered, log in and link the social account")
+	ErrSocialIdentityTaken   = errors.New("social account is linked to another user")
+	ErrProviderAlreadyLinked = errors.New("another account of this provider is already linked")
+	ErrIdentityNotLinked     = errors.New("social account is not linked")
+	ErrLastLoginMethod       = errors.New("cannot unlink the only login method")
+)
+
+// fetchSocialProfile: 제공자 토큰으로 사용자 정보를 확인하고 DB에 저장할 제공자 이름과 같이 돌려줌
+func (s *service) fetchSocialProfile(ctx context.Context, provider userpb.SocialProvider, accessToken string) (string, *SocialProfile, error) {
+	name, err := providerName(provider)
+	if err != nil {
+		return "", nil, err
+	}
+	p, ok := s.social[provider]
+	if !ok {
+		return "", nil, ErrUnsupportedProvider
+	}
+	profile, err := p.FetchProfile(ctx, accessToken)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, profile, nil
+}
+
+// SocialLogin: 소셜 계정으로 로그인. 연결된 유저가 없으면 새로 만들어서 연결함
+// 새 유저는 비밀번호가 없으므로(password_hash가 빈 문자열) 아이디/비밀번호 로그인은 안 됨
+func (s *service) SocialLogin(ctx context.Context, provider userpb.SocialProvider, accessToken string) (*User, error) {
+	name, profile, err := s.fetchSocialProfile(ctx, provider, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// 1. 이미 연결된 계정이면 그 유저로 로그인
+	const qUser = `
+		SELECT u.id, u.username, u.name, u.phone, u.phone_verified,
+		       u.email, u.nickname, u.avatar_url, u.created_at, u.disabled_at, u.role
+		FROM user_identities i
+		JOIN users u ON u.id = i.user_id
+		WHERE i.provider = $1 AND i.provider_user_id = $2
+	`
+	var u User
+	err = tx.QueryRow(ctx, qUser, name, profile.ProviderUserID).Scan(
+		&u.ID,
+		&u.Username,
+		&u.Name,
+		&u.Phone,
+		&u.PhoneVerified,
+		&u.Email,
+		&u.Nickname,
+		&u.AvatarURL,
+		&u.CreatedAt,
+		&u.DisabledAt,
+		&u.Role,
+	)
+	if err == nil {
+		if u.DisabledAt != nil {
+			return nil, ErrUserDisabled
+		}
+		return &u, tx.Commit(ctx)
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	// 2. 처음 보는 계정이면 유저를 만듦
+	//    인증된 이메일이 이미 가입돼 있으면 자동으로 합치지 않음 (이메일만으로 남의 계정에 붙는 것 방지)
+	email := fmt.Sprintf("%s_%s@social.invalid", name, profile.ProviderUserID)
+	if profile.EmailVerified {
+		var taken bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM users WHERE email = $1)`, profile.Email).Scan(&taken); err != nil {
+			return nil, err
+		}
+		if taken {
+			return nil, ErrSocialEmailTaken
+		}
+		email = profile.Email
+	}
+
+	username, err := socialUsername(ctx, tx, name, profile.ProviderUserID)
+	if err != nil {
+		return nil, err
+	}
+	nickname := profile.Nickname
+	if nickname == "" {
+		nickname = "user_" + username
+	}
+	var avatarURL *string
+	if profile.AvatarURL != "" {
+		avatarURL = &profile.AvatarURL
+	}
+
+	const qInsert = `
+		INSERT INTO users (username, name, email, password_hash, nickname, avatar_url)
+		VALUES ($1, $2, $3, '', $4, $5)
+		RETURNING id, username, name, phone, phone_verified,
+		          email, nickname, avatar_url, created_at, role
+	`
+	err = tx.QueryRow(ctx, qInsert, username, nickname, email, nickname, avatarURL).Scan(
+		&u.ID,
+		&u.Username,
+		&u.Name,
+		&u.Phone,
+		&u.PhoneVerified,
+		&u.Email,
+		&u.Nickname,
+		&u.AvatarURL,
+		&u.CreatedAt,
+		&u.Role,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := insertIdentity(ctx, tx, u.ID, name, profile); err != nil {
+		return nil, err
+	}
+	return &u, tx.Commit(ctx)
+}
+
+// socialUsername: 소셜 가입 유저의 아이디 (<제공자>_<회원번호>, 겹치면 뒤에 임의 문자열)
+func socialUsername(ctx context.Context, tx pgx.Tx, provider, providerUserID string) (string, error) {
+	username := provider + "_" + providerUserID
+	for i := 0; i < 5; i++ {
+		var taken bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM users WHERE username = $1)`, username).Scan(&taken); err != nil {
+			return "", err
+		}
+		if !taken {
+			return username, nil
+		}
+		b := make([]byte, 3)
+		if _, err := rand.Read(b); err != nil {
+			return "", err
+		}
+		username = provider + "_" + providerUserID + "_" + hex.EncodeToString(b)
+	}
+	return "", ErrUsernameTaken
+}
+
+func insertIdentity(ctx context.Context, tx pgx.Tx, userID, provider string, profile *SocialProfile) error {
+	var email *string
+	if profile.Email != "" {
+		email = &profile.Email
+	}
+
+	const q = `
+		INSERT INTO user_identities (provider, provider_user_id, user_id, email)
+		VALUES ($1, $2, $3, $4)
+	`
+	_, err := tx.Exec(ctx, q, provider, profile.ProviderUserID, userID, email)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "user_identities_pkey":
+			return ErrSocialIdentityTaken
+		case "user_identities_user_provider_key":
+			return ErrProviderAlreadyLinked
+		}
+	}
+	return err
+}
+
+// LinkSocialAccount: 로그인한 유저에게 소셜 계정을 연결하고 연결된 목록을 돌려줌 (이미 연결돼 있으면 그대로)
+func (s *service) LinkSocialAccount(ctx context.Context, userID string, provider userpb.SocialProvider, accessToken string) ([]*Identity, error) {
+	name, profile, err := s.fetchSocialProfile(ctx, provider, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var owner string
+	err = tx.QueryRow(ctx, `SELECT user_id FROM user_identities WHERE provider = $1 AND provider_user_id = $2`, name, profile.ProviderUserID).Scan(&owner)
+	switch {
+	case err == nil && owner != userID:
+		return nil, ErrSocialIdentityTaken
+	case errors.Is(err, pgx.ErrNoRows):
+		if err := insertIdentity(ctx, tx, userID, name, profile); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return s.ListSocialAccounts(ctx, userID)
+}
+
+// UnlinkSocialAccount: 소셜 계정 연결 해제
+// 비밀번호가 없는 유저의 마지막 소셜 계정은 해제하면 로그인할 방법이 없으므로 ErrLastLoginMethod
+func (s *service) UnlinkSocialAccount(ctx context.Context, userID string, provider userpb.SocialProvider) ([]*Identity, error) {
+	name, err := providerName(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// 같은 유저의 동시 해제 요청이 둘 다 "다른 로그인 수단이 있다"고 보지 않도록 유저 행을 잠금
+	var hasPassword bool
+	if err := tx.QueryRow(ctx, `SELECT password_hash <> '' FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&hasPassword); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	var linked int
+	if err := tx.QueryRow(ctx, `SELECT count(*) FROM user_identities WHERE user_id = $1`, userID).Scan(&linked); err != nil {
+		return nil, err
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM user_identities WHERE user_id = $1 AND provider = $2`, userID, name)
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, ErrIdentityNotLinked
+	}
+	if !hasPassword && linked <= 1 {
+		return nil, ErrLastLoginMethod
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return s.ListSocialAccounts(ctx, userID)
+}
+
+// ListSocialAccounts: 유저에게 연결된 소셜 계정 목록 (연결 순서)
+func (s *service) ListSocialAccounts(ctx context.Context, userID string) ([]*Identity, error) {
+	const q = `
+		SELECT provider, provider_user_id, COALESCE(email, ''), created_at
+		FROM user_identities
+		WHERE user_id = $1
+		ORDER BY created_at
+	`
+	rows, err := s.db.Query(ctx, q, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	identities := []*Identity{}
+	for rows.Next() {
+		var (
+			id   Identity
+			name string
+		)
+		if err := rows.Scan(&name, &id.ProviderUserID, &id.Email, &id.CreatedAt); err != nil {
+			return nil, err
+		}
+		id.Provider = providerFromName(name)
+		identities = append(identities, &id)
+	}
+	return identities, rows.Err()
+}
@@ -0,0 +1,414 @@
+package user
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ===== 서명 키 모음 (kid별 RS256 / EdDSA 키) =====
+//
+// 키 디렉터리 구조 (auth.signing_keys_dir)
+//   - <kid>.pem:     PKCS#8 개인 키 (RSA 또는 Ed25519). 서명과 검증에 모두 쓰임
+//   - <kid>.pub.pem: PKIX 공개 키. 은퇴한 키처럼 검증에만 쓰임
+//
+// 키 교체: 새 키를 추가하면(파일 이름순 마지막 개인 키가 서명 키) 이전 키로 서명된 토큰도 계속 검증되고,
+// access token 유효 기간이 지나면 이전 키를 .pub.pem으로 바꾸거나 지우면 됩니다.
+
+// JWKSPath: usersvc가 공개 키 문서를 내보내는 경로
+const JWKSPath = "/.well-known/jwks.json"
+
+// 원격 JWKS에 없는 kid가 들어와도 이 간격보다 자주 다시 받지 않음
+const jwksMinRefreshInterval = 30 * time.Second
+
+var ErrUnknownKeyID = errors.New("unknown signing key id")
+
+type signingKey struct {
+	kid    string
+	method jwt.SigningMethod
+	key    crypto.Signer
+}
+
+// KeySet: 서명 키(있으면)와 kid → 검증용 공개 키
+type KeySet struct {
+	signing *signingKey
+	keys    map[string]crypto.PublicKey
+	remote  *remoteJWKS // auth.jwks를 설정한 검증 전용 서비스
+}
+
+// signingMethodFor: 키 종류에 맞는 서명 방식 (RSA → RS256, Ed25519 → EdDSA)
+func signingMethodFor(pub crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch pub.(type) {
+	case *rsa.PublicKey:
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", pub)
+	}
+}
+
+// LoadKeyDir: 키 디렉터리를 읽음. activeKID가 비어 있으면 이름순 마지막 개인 키로 서명함
+func LoadKeyDir(dir, activeKID string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	ks := &KeySet{keys: make(map[string]crypto.PublicKey)}
+	signers := make(map[string]crypto.Signer)
+	var lastPrivate string
+	for _, path := range paths {
+		name := filepath.Base(path)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("%s: not a PEM file", path)
+		}
+
+		if kid, ok := strings.CutSuffix(name, ".pub.pem"); ok {
+			pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			if _, err := signingMethodFor(pub); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			ks.keys[kid] = pub
+			continue
+		}
+
+		kid := strings.TrimSuffix(name, ".pem")
+		priv, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		signer, ok := priv.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("%s: unsupported key type %T", path, priv)
+		}
+		if _, err := signingMethodFor(signer.Public()); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		ks.keys[kid] = signer.Public()
+		signers[kid] = signer
+		lastPrivate = kid
+	}
+
+	if activeKID == "" {
+		activeKID = lastPrivate
+	}
+	if activeKID == "" {
+		return nil, fmt.Errorf("%s: no private signing key (<kid>.pem)", dir)
+	}
+	signer, ok := signers[activeKID]
+	if !ok {
+		return nil, fmt.Errorf("%s: signing key %q not found", dir, activeKID)
+	}
+	method, _ := signingMethodFor(signer.Public())
+	ks.signing = &signingKey{kid: activeKID, method: method, key: signer}
+	return ks, nil
+}
+
+// publicKey: kid에 해당하는 검증용 공개 키 (로컬 키 → 원격 JWKS 순서)
+func (ks *KeySet) publicKey(kid string) (crypto.PublicKey, error) {
+	if pub, ok := ks.keys[kid]; ok {
+		return pub, nil
+	}
+	if ks.remote != nil {
+		return ks.remote.publicKey(kid)
+	}
+	return nil, ErrUnknownKeyID
+}
+
+// ===== JWKS 문서 =====
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Crv string `json:"crv,omitempty"` // OKP
+	X   string `json:"x,omitempty"`   // OKP
+	N   string `json:"n,omitempty"`   // RSA
+	E   string `json:"e,omitempty"`   // RSA
+}
+
+type jwksDocument struct {
+	Keys []jwk `json:"keys"`
+}
+
+// JWKS: 로컬 키의 공개 키 문서 (개인 키 정보는 들어가지 않음)
+func (ks *KeySet) JWKS() ([]byte, error) {
+	doc := jwksDocument{Keys: []jwk{}}
+	kids := make([]string, 0, len(ks.keys))
+	for kid := range ks.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	for _, kid := range kids {
+		switch pub := ks.keys[kid].(type) {
+		case *rsa.PublicKey:
+			doc.Keys = append(doc.Keys, jwk{
+				Kty: "RSA", Kid: kid, Use: "sig", Alg: jwt.SigningMethodRS256.Alg(),
+				N: base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				E: base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			doc.Keys = append(doc.Keys, jwk{
+				Kty: "OKP", Kid: kid, Use: "sig", Alg: jwt.SigningMethodEdDSA.Alg(),
+				Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(pub),
+			})
+		}
+	}
+	return json.Marshal(doc)
+}
+
+// ParseJWKS: JWKS 문서에서 kid → 공개 키를 읽음 (서명용이 아닌 키와 모르는 종류는 건너뜀)
+func ParseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var doc jwksDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(doc.Keys))
+	for _, k := range doc.Keys {
+		if k.Kid == "" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		switch {
+		case k.Kty == "RSA":
+			n, err := base64.RawURLEncoding.DecodeString(k.N)
+			if err != nil {
+				return nil, fmt.Errorf("JWKS key %s: invalid n", k.Kid)
+			}
+			e, err := base64.RawURLEncoding.DecodeString(k.E)
+			if err != nil || len(e) == 0 || len(e) > 4 {
+				return nil, fmt.Errorf("JWKS key %s: invalid e", k.Kid)
+			}
+			keys[k.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		case k.Kty == "OKP" && k.Crv == "Ed25519":
+			x, err := base64.RawURLEncoding.DecodeString(k.X)
+			if err != nil || len(x) != ed25519.PublicKeySize {
+				return nil, fmt.Errorf("JWKS key %s: invalid x", k.Kid)
+			}
+			keys[k.Kid] = ed25519.PublicKey(x)
+		}
+	}
+	return keys, nil
+}
+
+// ServeHTTP: JWKS 문서 응답
+func (ks *KeySet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ks.JWKS()
+	if err != nil {
+		http.Error(w, "failed to build JWKS", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.Write(body)
+}
+
+// JWKSHandler: ConfigureAuth로 읽은 키의 공개 키 문서 핸들러 (JWKSPath에 붙임, 키가 없으면 빈 문서)
+func JWKSHandler() http.Handler {
+	if authKeys == nil {
+		return &KeySet{}
+	}
+	return authKeys
+}
+
+// ===== 원격 JWKS (검증 전용 서비스) =====
+
+// remoteJWKS: URL이나 파일에서 받은 공개 키. 조회할 때 오래됐거나 모르는 kid면 다시 받음
+type remoteJWKS struct {
+	source   string // http(s) URL 또는 파일 경로
+	interval time.Duration
+	client   *http.Client
+
+	fetchMu sync.Mutex // 동시에 한 번만 받음
+
+	mu          sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	fetchedAt   time.Time
+	lastAttempt time.Time
+}
+
+func newRemoteJWKS(source string, interval time.Duration) *remoteJWKS {
+	return &remoteJWKS{
+		source:   source,
+		interval: interval,
+		client:   &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+func (r *remoteJWKS) read() ([]byte, error) {
+	if !strings.HasPrefix(r.source, "http://") && !strings.HasPrefix(r.source, "https://") {
+		return os.ReadFile(r.source)
+	}
+
+	resp, err := r.client.Get(r.source)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", r.source, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+// refresh: 문서를 다시 받음 (실패하면 이전 키를 그대로 씀)
+func (r *remoteJWKS) refresh() error {
+	r.mu.Lock()
+	r.lastAttempt = time.Now()
+	r.mu.Unlock()
+
+	data, err := r.read()
+	if err != nil {
+		return err
+	}
+	keys, err := ParseJWKS(data)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.keys = keys
+	r.fetchedAt = time.Now()
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *remoteJWKS) publicKey(kid string) (crypto.PublicKey, error) {
+	r.mu.RLock()
+	pub, ok := r.keys[kid]
+	stale := time.Since(r.fetchedAt) > r.interval
+	canRetry := time.Since(r.lastAttempt) > jwksMinRefreshInterval
+	r.mu.RUnlock()
+
+	if (ok && !stale) || !canRetry {
+		if ok {
+			return pub, nil
+		}
+		return nil, ErrUnknownKeyID
+	}
+
+	r.fetchMu.Lock()
+	defer r.fetchMu.Unlock()
+	// 기다리는 동안 다른 요청이 이미 받았으면 그 결과를 씀
+	r.mu.RLock()
+	fresh := time.Since(r.lastAttempt) <= jwksMinRefreshInterval
+	r.mu.RUnlock()
+	if !fresh {
+		if err := r.refresh(); err != nil {
+			log.Printf("JWKS 갱신 실패 (%s): %v", r.source, err)
+		}
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if pub, ok := r.keys[kid]; ok {
+		return pub, nil
+	}
+	return nil, ErrUnknownKeyID
+}
+
+// ===== 키 생성 (cli keys generate) =====
+
+// GenerateSigningKey: dir에 새 개인 키(<kid>.pem)를 만들고 kid를 돌려줌
+// kid는 생성 시각이라 이름순 마지막 = 가장 최근 키가 되어 다음 시작부터 서명 키로 쓰임
+func GenerateSigningKey(dir, alg string) (string, error) {
+	var priv any
+	switch alg {
+	case "ed25519", "eddsa", "":
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return "", err
+		}
+		priv = key
+	case "rsa", "rs256":
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return "", err
+		}
+		priv = key
+	default:
+		return "", fmt.Errorf("unsupported key algorithm %q (ed25519, rsa)", alg)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+
+	kid := time.Now().UTC().Format("20060102T150405Z")
+	path := filepath.Join(dir, kid+".pem")
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return "", err
+	}
+	if err := pem.Encode(f, &pem.Block{Type: "PRIVATE KEY", Bytes: der}); err != nil {
+		f.Close()
+		return "", err
+	}
+	return kid, f.Close()
+}
+
+// RetireSigningKey: 개인 키를 공개 키(<kid>.pub.pem)로 바꿔서 검증에만 쓰이게 함
+func RetireSigningKey(dir, kid string) error {
+	path := filepath.Join(dir, kid+".pem")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return fmt.Errorf("%s: not a PEM file", path)
+	}
+	priv, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	signer, ok := priv.(crypto.Signer)
+	if !ok {
+		return fmt.Errorf("%s: unsupported key type %T", path, priv)
+	}
+	der, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return err
+	}
+
+	pubPath := filepath.Join(dir, kid+".pub.pem")
+	if err := os.WriteFile(pubPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o644); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
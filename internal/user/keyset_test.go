@@ -0,0 +1,145 @@
+package user
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// configureAuth: 테스트가 끝나면 전역 인증 설정을 되돌림
+func configureAuth(t *testing.T, cfg config.AuthConfig) {
+	t.Helper()
+	prevSecret, prevKeys := authSecret, authKeys
+	t.Cleanup(func() { authSecret, authKeys = prevSecret, prevKeys })
+	if err := ConfigureAuth(cfg); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func tokenHeader(t *testing.T, tokenStr string) map[string]any {
+	t.Helper()
+	token, _, err := jwt.NewParser().ParseUnverified(tokenStr, &Claims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token.Header
+}
+
+func TestKeyRotation(t *testing.T) {
+	dir := t.TempDir()
+	oldKID, err := GenerateSigningKey(dir, "rsa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 두 번째 키와 kid(생성 시각)가 겹치지 않도록 이름을 앞 순서로 바꿈
+	if err := os.Rename(filepath.Join(dir, oldKID+".pem"), filepath.Join(dir, "0001.pem")); err != nil {
+		t.Fatal(err)
+	}
+	newKID, err := GenerateSigningKey(dir, "ed25519")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &User{ID: "u1", Username: "alice"}
+
+	// 예전 키로 서명된 토큰
+	configureAuth(t, config.AuthConfig{SigningKeysDir: dir, SigningKeyID: "0001"})
+	oldToken, err := GenerateAccessToken(u, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h := tokenHeader(t, oldToken); h["kid"] != "0001" || h["alg"] != "RS256" {
+		t.Fatalf("old token header = %v, want kid 0001 RS256", h)
+	}
+
+	// 기본 서명 키는 이름순 마지막 개인 키
+	configureAuth(t, config.AuthConfig{SigningKeysDir: dir})
+	newToken, err := GenerateAccessToken(u, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h := tokenHeader(t, newToken); h["kid"] != newKID || h["alg"] != "EdDSA" {
+		t.Fatalf("new token header = %v, want kid %s EdDSA", h, newKID)
+	}
+
+	// 은퇴한 키로 서명된 토큰도 계속 검증됨
+	if err := RetireSigningKey(dir, "0001"); err != nil {
+		t.Fatal(err)
+	}
+	configureAuth(t, config.AuthConfig{SigningKeysDir: dir})
+	for name, tok := range map[string]string{"old": oldToken, "new": newToken} {
+		claims, err := ParseAndValidateToken(tok)
+		if err != nil || claims.UserID != "u1" || claims.SessionID != "s1" {
+			t.Errorf("%s token: claims %+v, err %v", name, claims, err)
+		}
+	}
+
+	if _, err := LoadKeyDir(dir, "0001"); err == nil {
+		t.Error("retired key can still be selected for signing")
+	}
+}
+
+func TestVerifyWithRemoteJWKS(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := GenerateSigningKey(dir, "ed25519"); err != nil {
+		t.Fatal(err)
+	}
+	configureAuth(t, config.AuthConfig{SigningKeysDir: dir})
+	token, err := GenerateAccessToken(&User{ID: "u1", Username: "alice"}, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(JWKSHandler())
+	defer srv.Close()
+
+	// 개인 키 없이 공개 키 문서만으로 검증 (HS256 비밀 키도 없음)
+	t.Setenv("JWT_SECRET", "")
+	configureAuth(t, config.AuthConfig{JWKS: srv.URL, JWKSRefreshInterval: time.Minute})
+	if _, err := GenerateAccessToken(&User{ID: "u1"}, "s1"); err == nil {
+		t.Error("verify-only config can sign tokens")
+	}
+	if claims, err := ParseAndValidateToken(token); err != nil || claims.UserID != "u1" {
+		t.Fatalf("claims %+v, err %v", claims, err)
+	}
+
+	// 비밀 키가 없으면 HS256 토큰은 거부
+	hs := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{UserID: "u1"})
+	hsToken, err := hs.SignedString([]byte("guess"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseAndValidateToken(hsToken); err == nil {
+		t.Error("HS256 token accepted without a configured secret")
+	}
+}
+
+func TestParseJWKSRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	kid, err := GenerateSigningKey(dir, "rsa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks, err := LoadKeyDir(dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := ks.JWKS()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := ParseJWKS(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, ok := keys[kid]
+	if !ok {
+		t.Fatalf("JWKS %s missing kid %s", doc, kid)
+	}
+	if method, err := signingMethodFor(pub); err != nil || method.Alg() != "RS256" {
+		t.Fatalf("parsed key %T: %v", pub, err)
+	}
+}
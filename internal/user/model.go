@@ -1,6 +1,10 @@
 package user
 
-import "time"
+import (
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/userpb"
+)
 
 type User struct {
 	ID            string
@@ -14,4 +18,14 @@ type User struct {
 	AvatarURL     *string
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	DisabledAt    *time.Time // 관리자가 차단한 시각 (차단 안 됐으면 nil)
+	Role          Role
+}
+
+// Identity: 유저에게 연결된 소셜 계정 (user_identities)
+type Identity struct {
+	Provider       userpb.SocialProvider
+	ProviderUserID string
+	Email          string
+	CreatedAt      time.Time
 }
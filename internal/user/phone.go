@@ -0,0 +1,288 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrInvalidPhone           = errors.New("invalid phone number")
+	ErrVerificationNotFound   = errors.New("verification not found")
+	ErrVerificationExpired    = errors.New("verification code expired")
+	ErrTooManyAttempts        = errors.New("too many verification attempts")
+	ErrVerificationInProgress = errors.New("verification code was sent recently")
+	ErrVerificationNotUsable  = errors.New("phone verification is not verified for this number, expired or already used")
+)
+
+// 인증을 마친 인증 ID는 이 시간 안에 SignUp / UpdateProfile에 보내야 phone_verified가 됨
+const verifiedPhoneWindow = 30 * time.Minute
+
+var phonePattern = regexp.MustCompile(`^\+?[0-9]{9,15}$`)
+
+// normalizePhone: 공백, -, 괄호, 점을 빼고 숫자(와 앞의 +)만 남김 ("010-1234-5678" → "01012345678")
+func normalizePhone(phone string) (string, error) {
+	n := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '(', ')', '.':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(phone))
+	if !phonePattern.MatchString(n) {
+		return "", ErrInvalidPhone
+	}
+	return n, nil
+}
+
+// PhoneVerificationRecord는 phone_verifications 테이블의 한 행입니다.
+type PhoneVerificationRecord struct {
+	ID         string
+	Phone      string // 정규화된 번호
+	CodeHash   string
+	Attempts   int
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	VerifiedAt *time.Time
+	ConsumedAt *time.Time // SignUp / UpdateProfile에서 쓴 시각 (한 번만 쓸 수 있음)
+}
+
+// PhoneVerificationStore: 인증번호 저장소
+type PhoneVerificationStore interface {
+	// 번호로 가장 최근에 발급한 인증 (없으면 nil)
+	LatestVerification(ctx context.Context, phone string) (*PhoneVerificationRecord, error)
+	CreateVerification(ctx context.Context, rec *PhoneVerificationRecord) error
+	DeleteVerification(ctx context.Context, id string) error
+	// 없으면 ErrVerificationNotFound
+	GetVerification(ctx context.Context, id string) (*PhoneVerificationRecord, error)
+	// 시도 횟수를 1 올리고 올린 값을 돌려줌 (동시에 여러 번 시도해도 상한을 넘지 않도록 DB에서 원자적으로)
+	IncrementAttempts(ctx context.Context, id string) (int, error)
+	// 인증 완료 처리 (유저의 phone_verified는 인증 ID를 받은 SignUp / UpdateProfile이 consumePhoneVerification으로 바꿈)
+	MarkVerified(ctx context.Context, id string) error
+}
+
+// PhoneVerifier: 인증번호 발급/확인
+type PhoneVerifier struct {
+	store PhoneVerificationStore
+	sms   SMSSender
+	cfg   config.PhoneConfig
+	now   func() time.Time
+}
+
+func NewPhoneVerifier(store PhoneVerificationStore, sms SMSSender, cfg config.PhoneConfig) *PhoneVerifier {
+	return &PhoneVerifier{store: store, sms: sms, cfg: cfg, now: time.Now}
+}
+
+// hashCode: id와 번호에 묶인 인증번호 해시 (다른 인증/다른 번호에는 같은 코드가 통하지 않음)
+func hashCode(id, phone, code string) string {
+	sum := sha256.Sum256([]byte(id + "|" + phone + "|" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+// newVerificationID: UUID v4 (코드 해시에 id가 들어가므로 저장 전에 만듦)
+func newVerificationID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
+
+// newCode: 6자리 숫자 인증번호
+func newCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// Request: 인증번호를 만들어 문자로 보내고 인증 ID를 돌려줌
+// 같은 번호로 phone.resend_cooldown 안에 다시 요청하면 ErrVerificationInProgress
+func (v *PhoneVerifier) Request(ctx context.Context, phone string) (string, error) {
+	phone, err := normalizePhone(phone)
+	if err != nil {
+		return "", err
+	}
+
+	now := v.now()
+	latest, err := v.store.LatestVerification(ctx, phone)
+	if err != nil {
+		return "", err
+	}
+	if latest != nil && latest.VerifiedAt == nil && now.Sub(latest.CreatedAt) < v.cfg.ResendCooldown {
+		return "", ErrVerificationInProgress
+	}
+
+	id, err := newVerificationID()
+	if err != nil {
+		return "", err
+	}
+	code, err := newCode()
+	if err != nil {
+		return "", err
+	}
+
+	rec := &PhoneVerificationRecord{
+		ID:        id,
+		Phone:     phone,
+		CodeHash:  hashCode(id, phone, code),
+		ExpiresAt: now.Add(v.cfg.CodeTTL),
+		CreatedAt: now,
+	}
+	if err := v.store.CreateVerification(ctx, rec); err != nil {
+		return "", err
+	}
+
+	message := fmt.Sprintf("[GDG Chat] 인증번호 [%s]를 입력해주세요. (%d분 안에 입력)", code, int(v.cfg.CodeTTL.Minutes()))
+	if err := v.sms.Send(ctx, phone, message); err != nil {
+		// 못 보낸 인증번호 때문에 재요청이 막히지 않도록 지움
+		v.store.DeleteVerification(context.WithoutCancel(ctx), id)
+		return "", fmt.Errorf("failed to send sms: %w", err)
+	}
+	return id, nil
+}
+
+// Verify: 인증번호 확인. 맞으면 true (이미 인증된 ID면 다시 true), 틀리면 false
+func (v *PhoneVerifier) Verify(ctx context.Context, verificationID, code string) (bool, error) {
+	if !uuidPattern.MatchString(verificationID) {
+		return false, ErrVerificationNotFound
+	}
+
+	rec, err := v.store.GetVerification(ctx, verificationID)
+	if err != nil {
+		return false, err
+	}
+	if rec.VerifiedAt != nil {
+		return true, nil
+	}
+	if v.now().After(rec.ExpiresAt) {
+		return false, ErrVerificationExpired
+	}
+
+	// 코드 비교 전에 시도 횟수부터 올림 (틀린 시도가 상한을 넘으면 맞는 코드도 거부)
+	attempts, err := v.store.IncrementAttempts(ctx, verificationID)
+	if err != nil {
+		return false, err
+	}
+	if attempts > v.cfg.MaxAttempts {
+		return false, ErrTooManyAttempts
+	}
+
+	want := []byte(rec.CodeHash)
+	got := []byte(hashCode(rec.ID, rec.Phone, strings.TrimSpace(code)))
+	if subtle.ConstantTimeCompare(want, got) != 1 {
+		return false, nil
+	}
+
+	if err := v.store.MarkVerified(ctx, rec.ID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ---------------------------
+// Postgres 저장소
+// ---------------------------
+
+type postgresPhoneStore struct {
+	db *pgxpool.Pool
+}
+
+func NewPhoneVerificationStore(db *pgxpool.Pool) PhoneVerificationStore {
+	return &postgresPhoneStore{db: db}
+}
+
+const phoneVerificationColumns = `id, phone, code_hash, attempts, expires_at, created_at, verified_at, consumed_at`
+
+func scanPhoneVerification(row pgx.Row) (*PhoneVerificationRecord, error) {
+	var rec PhoneVerificationRecord
+	err := row.Scan(&rec.ID, &rec.Phone, &rec.CodeHash, &rec.Attempts, &rec.ExpiresAt, &rec.CreatedAt, &rec.VerifiedAt, &rec.ConsumedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func (r *postgresPhoneStore) LatestVerification(ctx context.Context, phone string) (*PhoneVerificationRecord, error) {
+	q := `SELECT ` + phoneVerificationColumns + ` FROM phone_verifications WHERE phone = $1 ORDER BY created_at DESC LIMIT 1`
+	rec, err := scanPhoneVerification(r.db.QueryRow(ctx, q, phone))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return rec, err
+}
+
+func (r *postgresPhoneStore) CreateVerification(ctx context.Context, rec *PhoneVerificationRecord) error {
+	const q = `
+		INSERT INTO phone_verifications (id, phone, code_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := r.db.Exec(ctx, q, rec.ID, rec.Phone, rec.CodeHash, rec.ExpiresAt, rec.CreatedAt)
+	return err
+}
+
+func (r *postgresPhoneStore) DeleteVerification(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM phone_verifications WHERE id = $1`, id)
+	return err
+}
+
+func (r *postgresPhoneStore) GetVerification(ctx context.Context, id string) (*PhoneVerificationRecord, error) {
+	q := `SELECT ` + phoneVerificationColumns + ` FROM phone_verifications WHERE id = $1`
+	rec, err := scanPhoneVerification(r.db.QueryRow(ctx, q, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrVerificationNotFound
+	}
+	return rec, err
+}
+
+func (r *postgresPhoneStore) IncrementAttempts(ctx context.Context, id string) (int, error) {
+	var attempts int
+	err := r.db.QueryRow(ctx, `UPDATE phone_verifications SET attempts = attempts + 1 WHERE id = $1 RETURNING attempts`, id).Scan(&attempts)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, ErrVerificationNotFound
+	}
+	return attempts, err
+}
+
+func (r *postgresPhoneStore) MarkVerified(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, `UPDATE phone_verifications SET verified_at = now() WHERE id = $1 AND verified_at IS NULL`, id)
+	return err
+}
+
+// consumePhoneVerification: 인증을 마친 인증 ID를 phone 번호로 한 번 사용함 (유저 INSERT/UPDATE와 같은 트랜잭션에서 호출)
+// 다른 번호의 인증, 인증하지 않은 ID, verifiedPhoneWindow가 지난 인증, 이미 쓴 인증이면 ErrVerificationNotUsable
+// 트랜잭션이 rollback되면 사용 표시도 같이 취소됨
+func consumePhoneVerification(ctx context.Context, tx pgx.Tx, id, phone string) error {
+	phone, err := normalizePhone(phone)
+	if err != nil || !uuidPattern.MatchString(id) {
+		return ErrVerificationNotUsable
+	}
+	const q = `
+		UPDATE phone_verifications
+		SET consumed_at = now()
+		WHERE id = $1 AND phone = $2 AND consumed_at IS NULL AND verified_at > $3
+	`
+	tag, err := tx.Exec(ctx, q, id, phone, time.Now().Add(-verifiedPhoneWindow))
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() != 1 {
+		return ErrVerificationNotUsable
+	}
+	return nil
+}
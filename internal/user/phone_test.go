@@ -0,0 +1,292 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/db"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// memPhoneStore: PhoneVerificationStore 메모리 구현
+type memPhoneStore struct {
+	mu   sync.Mutex
+	recs map[string]*PhoneVerificationRecord
+}
+
+func newMemPhoneStore() *memPhoneStore {
+	return &memPhoneStore{recs: make(map[string]*PhoneVerificationRecord)}
+}
+
+func (m *memPhoneStore) LatestVerification(ctx context.Context, phone string) (*PhoneVerificationRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var latest *PhoneVerificationRecord
+	for _, r := range m.recs {
+		if r.Phone == phone && (latest == nil || r.CreatedAt.After(latest.CreatedAt)) {
+			c := *r
+			latest = &c
+		}
+	}
+	return latest, nil
+}
+
+func (m *memPhoneStore) CreateVerification(ctx context.Context, rec *PhoneVerificationRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c := *rec
+	m.recs[rec.ID] = &c
+	return nil
+}
+
+func (m *memPhoneStore) DeleteVerification(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.recs, id)
+	return nil
+}
+
+func (m *memPhoneStore) GetVerification(ctx context.Context, id string) (*PhoneVerificationRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.recs[id]
+	if !ok {
+		return nil, ErrVerificationNotFound
+	}
+	c := *r
+	return &c, nil
+}
+
+func (m *memPhoneStore) IncrementAttempts(ctx context.Context, id string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.recs[id]
+	if !ok {
+		return 0, ErrVerificationNotFound
+	}
+	r.Attempts++
+	return r.Attempts, nil
+}
+
+func (m *memPhoneStore) MarkVerified(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	m.recs[id].VerifiedAt = &now
+	return nil
+}
+
+var smsCodePattern = regexp.MustCompile(`\[(\d{6})\]`)
+
+// lastCode: FileSMSSender가 남긴 파일에서 마지막 인증번호를 읽음
+func lastCode(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := smsCodePattern.FindAllStringSubmatch(string(data), -1)
+	if len(m) == 0 {
+		t.Fatalf("no code in sms log:\n%s", data)
+	}
+	return m[len(m)-1][1]
+}
+
+func wrongCode(code string) string {
+	if code == "000000" {
+		return "111111"
+	}
+	return "000000"
+}
+
+func newTestVerifier(t *testing.T) (*PhoneVerifier, *memPhoneStore, string, *time.Time) {
+	t.Helper()
+	smsFile := filepath.Join(t.TempDir(), "sms.log")
+	store := newMemPhoneStore()
+	v := NewPhoneVerifier(store, NewFileSMSSender(smsFile), config.Default().Phone)
+	now := time.Now()
+	v.now = func() time.Time { return now }
+	return v, store, smsFile, &now
+}
+
+func TestPhoneVerificationFlow(t *testing.T) {
+	ctx := context.Background()
+	v, store, smsFile, _ := newTestVerifier(t)
+
+	id, err := v.Request(ctx, "010-1234-5678")
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := lastCode(t, smsFile)
+
+	if ok, err := v.Verify(ctx, id, wrongCode(code)); ok || err != nil {
+		t.Fatalf("wrong code: ok=%v err=%v, want false, nil", ok, err)
+	}
+	if ok, err := v.Verify(ctx, id, code); !ok || err != nil {
+		t.Fatalf("right code: ok=%v err=%v", ok, err)
+	}
+	if rec, _ := store.GetVerification(ctx, id); rec.VerifiedAt == nil || rec.Phone != "01012345678" {
+		t.Errorf("verification not marked for the normalized number: %+v", rec)
+	}
+	// 인증된 ID는 다시 확인해도 성공
+	if ok, err := v.Verify(ctx, id, code); !ok || err != nil {
+		t.Fatalf("repeat verify: ok=%v err=%v", ok, err)
+	}
+}
+
+func TestPhoneVerificationLimits(t *testing.T) {
+	ctx := context.Background()
+	v, _, smsFile, now := newTestVerifier(t)
+	cfg := config.Default().Phone
+
+	if _, err := v.Request(ctx, "12ab"); err != ErrInvalidPhone {
+		t.Errorf("bad number: err = %v, want ErrInvalidPhone", err)
+	}
+
+	id, err := v.Request(ctx, "01012345678")
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := lastCode(t, smsFile)
+
+	// 재요청 쿨다운
+	if _, err := v.Request(ctx, "010 1234 5678"); err != ErrVerificationInProgress {
+		t.Errorf("resend within cooldown: err = %v, want ErrVerificationInProgress", err)
+	}
+
+	// 시도 횟수 상한을 넘으면 맞는 코드도 거부
+	for i := 0; i < cfg.MaxAttempts; i++ {
+		if ok, err := v.Verify(ctx, id, wrongCode(code)); ok || err != nil {
+			t.Fatalf("attempt %d: ok=%v err=%v", i+1, ok, err)
+		}
+	}
+	if _, err := v.Verify(ctx, id, code); err != ErrTooManyAttempts {
+		t.Errorf("after %d attempts: err = %v, want ErrTooManyAttempts", cfg.MaxAttempts, err)
+	}
+
+	// 쿨다운이 지나면 새 코드를 받을 수 있고, 유효 기간이 지나면 만료
+	*now = now.Add(cfg.ResendCooldown)
+	id2, err := v.Request(ctx, "01012345678")
+	if err != nil {
+		t.Fatalf("resend after cooldown: %v", err)
+	}
+	code2 := lastCode(t, smsFile)
+	*now = now.Add(cfg.CodeTTL + time.Second)
+	if _, err := v.Verify(ctx, id2, code2); err != ErrVerificationExpired {
+		t.Errorf("expired code: err = %v, want ErrVerificationExpired", err)
+	}
+
+	// 코드는 발급한 인증 ID(와 번호)에만 통함
+	*now = time.Now()
+	if _, err := v.Verify(ctx, "00000000-0000-4000-8000-000000000000", code2); err != ErrVerificationNotFound {
+		t.Errorf("unknown id: err = %v, want ErrVerificationNotFound", err)
+	}
+}
+
+func TestSMSSenderNeedsDevFlagForPlainTextCodes(t *testing.T) {
+	cfg := config.Default().Phone
+	for _, sender := range []string{"log", "file"} {
+		cfg.SMSSender, cfg.SMSFile, cfg.DevSMS = sender, filepath.Join(t.TempDir(), "sms.log"), false
+		if _, err := NewSMSSender(cfg); err == nil {
+			t.Errorf("sms_sender=%s without dev_sms: got a sender, want error", sender)
+		}
+		cfg.DevSMS = true
+		if _, err := NewSMSSender(cfg); err != nil {
+			t.Errorf("sms_sender=%s with dev_sms: %v", sender, err)
+		}
+	}
+
+	// 기본값(none)이면 인증번호 요청이 실패하고 기록도 남지 않음
+	sms, err := NewSMSSender(config.Default().Phone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := newMemPhoneStore()
+	v := NewPhoneVerifier(store, sms, config.Default().Phone)
+	if _, err := v.Request(context.Background(), "01012345678"); !errors.Is(err, ErrSMSNotConfigured) {
+		t.Fatalf("Request with sms_sender=none: err = %v, want ErrSMSNotConfigured", err)
+	}
+	if len(store.recs) != 0 {
+		t.Fatalf("%d verifications left after a failed send", len(store.recs))
+	}
+}
+
+// 인증 ID는 인증한 번호로, 한 번만, 그 요청의 유저에게만 쓸 수 있어야 함 (DATABASE_URL이 없으면 건너뜀)
+func TestPhoneVerificationIsSingleUse(t *testing.T) {
+	url := os.Getenv("DATABASE_URL")
+	if url == "" {
+		t.Skip("DATABASE_URL is not set")
+	}
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Close()
+	if err := db.ApplyMigrations(ctx, pool); err != nil {
+		t.Fatal(err)
+	}
+
+	smsFile := filepath.Join(t.TempDir(), "sms.log")
+	verifier := NewPhoneVerifier(NewPhoneVerificationStore(pool), NewFileSMSSender(smsFile), config.Default().Phone)
+	svc := NewService(pool, verifier, nil)
+
+	suffix := fmt.Sprintf("%08d", time.Now().UnixNano()%1e8)
+	phone, otherPhone := "010"+suffix, "011"+suffix
+	var names []string
+	t.Cleanup(func() {
+		pool.Exec(context.Background(), `DELETE FROM users WHERE username = ANY($1)`, names)
+		pool.Exec(context.Background(), `DELETE FROM phone_verifications WHERE phone = ANY($1)`, []string{phone, otherPhone})
+	})
+	signUp := func(name, phone, verificationID string) (*User, error) {
+		names = append(names, name)
+		return svc.SignUp(ctx, name, name, phone, name+"@example.test", "password-1234", verificationID)
+	}
+	verify := func(phone string) string {
+		id, err := verifier.Request(ctx, phone)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := verifier.Verify(ctx, id, lastCode(t, smsFile)); !ok || err != nil {
+			t.Fatalf("Verify: ok=%v err=%v", ok, err)
+		}
+		return id
+	}
+
+	// 같은 번호로 먼저 가입한 유저는 다른 사람의 인증으로 phone_verified가 되지 않음
+	bystander, err := signUp("by_"+suffix, phone, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := verify(phone)
+	if _, err := signUp("wrong_"+suffix, otherPhone, id); err != ErrVerificationNotUsable {
+		t.Fatalf("sign up with another number's verification: err = %v, want ErrVerificationNotUsable", err)
+	}
+	owner, err := signUp("owner_"+suffix, phone, id)
+	if err != nil || !owner.PhoneVerified {
+		t.Fatalf("sign up with verification: user=%+v err=%v", owner, err)
+	}
+	if _, err := signUp("again_"+suffix, phone, id); err != ErrVerificationNotUsable {
+		t.Fatalf("reused verification: err = %v, want ErrVerificationNotUsable", err)
+	}
+	if _, err := svc.UpdateProfile(ctx, bystander.ID, "", "", "", "", id); err != ErrVerificationNotUsable {
+		t.Fatalf("UpdateProfile with a used verification: err = %v, want ErrVerificationNotUsable", err)
+	}
+	if u, _ := svc.GetProfile(ctx, bystander.ID); u.PhoneVerified {
+		t.Fatal("another user's verification marked the bystander's phone as verified")
+	}
+
+	// 지금 번호를 새로 인증하면 그 유저만 phone_verified
+	u, err := svc.UpdateProfile(ctx, bystander.ID, "", "", "", "", verify(phone))
+	if err != nil || !u.PhoneVerified {
+		t.Fatalf("UpdateProfile with verification: user=%+v err=%v", u, err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -12,30 +13,93 @@ import (
 
 // MessageRecord는 DB에서 조회한 메시지 레코드 구조체입니다.
 type MessageRecord struct {
+	ID             string
 	RoomID         string
 	SenderID       string
 	Username       string
 	MessageContent string
 	SentAt         time.Time
+	ClientMsgID    string // 클라이언트가 만든 중복 방지 키 (없으면 빈 값)
 }
 
 // [추가] DB에서 가져올 방 정보 구조체
 type RoomInfoRecord struct {
-	RoomID  string
-	User1ID string
-	User2ID string
+	RoomID      string
+	User1ID     string // 1:1 방일 때만 채워짐
+	User2ID     string // 1:1 방일 때만 채워짐
+	RoomType    string // RoomTypeDirect 또는 RoomTypeGroup
+	Title       string
+	MemberCount int
+
+	// 조회한 유저 기준 정보 (GetRoomsByUser에서만 채워짐)
+	UnreadCount       int
+	LastReadMessageID string
+	OtherNickname     string // 1:1 방 상대방 닉네임
+	OtherAvatarURL    string // 1:1 방 상대방 프로필 이미지
+
+	// 마지막 메시지 미리보기 (메시지가 없으면 LastMessageID가 빈 값)
+	LastMessageID       string
+	LastMessagePreview  string
+	LastMessageSenderID string
+	LastMessageUsername string
+	LastMessageAt       time.Time
+
+	// 최근 활동 시각 = 마지막 메시지 시각, 메시지가 없으면 방 생성 시각
+	LastActivityAt time.Time
+}
+
+// 방 목록 미리보기 최대 글자 수
+const lastMessagePreviewLength = 100
+
+// RoomMemberRecord는 room_members 테이블의 한 행입니다.
+type RoomMemberRecord struct {
+	RoomID   string
+	UserID   string
+	Role     string
+	JoinedAt time.Time
 }
 
+// 방 종류 / 멤버 역할
+const (
+	RoomTypeDirect = "direct"
+	RoomTypeGroup  = "group"
+
+	RoomRoleOwner  = "owner"
+	RoomRoleMember = "member"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+var (
+	ErrRoomNotFound    = errors.New("room not found")
+	ErrMessageNotFound = errors.New("message not found")
+	ErrNotRoomMember   = errors.New("not a member of the room")
+)
+
 // ChatRepository는 채팅 데이터 영속성 처리를 위한 인터페이스입니다.
 type ChatRepository interface {
-	// rooms 테이블에 방이 존재하는지 확인하고, 없으면 생성합니다.
-	EnsureRoomExists(ctx context.Context, roomID, user1ID, user2ID string) error
+	// 두 유저(순서 무관)의 1:1 방을 조회하고, 없으면 새 UUID로 생성한 뒤 방 ID를 돌려줍니다.
+	EnsureDirectRoom(ctx context.Context, userAID, userBID string) (string, error)
+
+	// 메시지를 messages 테이블에 저장하고, 서버가 발급한 ID/저장 시각이 채워진 레코드를 돌려줍니다.
+	// clientMsgID가 같은 메시지가 이미 있으면 새로 저장하지 않고 기존 레코드와 duplicate=true를 돌려줍니다.
+	// 에러 없이 반환되면 commit이 끝난 것이고, 에러면 아무것도 저장되지 않은 것입니다.
+	// 보낸 사람(username)이 방 멤버가 아니면 저장하지 않고 ErrNotRoomMember를 돌려줍니다.
+	SaveMessage(ctx context.Context, roomID, senderID, username, messageContent, clientMsgID string) (record *MessageRecord, duplicate bool, err error)
+
+	// 방 안의 메시지 하나를 ID로 조회합니다. 없으면 ErrMessageNotFound
+	GetMessageByID(ctx context.Context, roomID, messageID string) (*MessageRecord, error)
 
-	// 메시지를 messages 테이블에 저장합니다.
-	SaveMessage(ctx context.Context, roomID, senderID, username, messageContent string) error
+	// 방 안의 메시지 하나를 지웁니다. (관리자용) 없으면 ErrMessageNotFound
+	DeleteMessage(ctx context.Context, roomID, messageID string) error
 
-	// 특정 방의 과거 메시지들을 조회합니다. (최신 순)
-	GetMessagesByRoomID(ctx context.Context, roomID string, limit int) ([]*MessageRecord, error)
+	// cursor보다 오래된 메시지를 최대 limit개 조회합니다. cursor가 nil이면 가장 최신 메시지부터.
+	// 결과는 오래된 순(sent_at, id 오름차순)입니다.
+	GetMessagesBefore(ctx context.Context, roomID string, cursor *MessageCursor, limit int) ([]*MessageRecord, error)
+
+	// cursor보다 최신 메시지를 최대 limit개 조회합니다. cursor가 nil이면 가장 오래된 메시지부터.
+	// 결과는 오래된 순(sent_at, id 오름차순)입니다.
+	GetMessagesAfter(ctx context.Context, roomID string, cursor *MessageCursor, limit int) ([]*MessageRecord, error)
 
 	// 유저가 실제 존재하는지 확인합니다.
 	UserExists(ctx context.Context, username string) (bool, error)
@@ -43,8 +107,37 @@ type ChatRepository interface {
 	// 유저의 UUID와 생성일시(가입순서 판단용)를 조회합니다.
 	GetUserInfo(ctx context.Context, username string) (string, time.Time, error)
 
-	// [추가] 내가 속한 방 목록 조회
-	GetRoomsByUser(ctx context.Context, userID string) ([]*RoomInfoRecord, error)
+	// [추가] 내가 속한 방 목록 조회 (최근 활동순)
+	// after가 nil이면 처음부터, 아니면 after 다음 방부터 최대 limit개
+	GetRoomsByUser(ctx context.Context, userID string, after *RoomCursor, limit int) ([]*RoomInfoRecord, error)
+
+	// 유저가 방의 멤버(room_members)인지 확인합니다.
+	IsRoomMember(ctx context.Context, roomID, userID string) (bool, error)
+
+	// 방 하나의 정보를 조회합니다. 없으면 ErrRoomNotFound
+	GetRoom(ctx context.Context, roomID string) (*RoomInfoRecord, error)
+
+	// 그룹 방을 만들고 ownerID(owner)와 memberIDs(member)를 멤버로 넣은 뒤 방 ID를 돌려줍니다.
+	CreateGroupRoom(ctx context.Context, title, ownerID string, memberIDs []string) (string, error)
+
+	// 방에 멤버를 추가합니다. (이미 멤버면 무시)
+	AddRoomMembers(ctx context.Context, roomID string, memberIDs []string) error
+
+	// 방에서 멤버를 제거합니다. 실제로 지워졌는지 여부를 돌려줍니다.
+	RemoveRoomMember(ctx context.Context, roomID, userID string) (bool, error)
+
+	// 방 이름을 바꿉니다.
+	RenameRoom(ctx context.Context, roomID, title string) error
+
+	// 방 멤버 목록을 참여 순서대로 조회합니다.
+	GetRoomMembers(ctx context.Context, roomID string) ([]*RoomMemberRecord, error)
+
+	// 멤버의 읽음 위치를 cursor로 옮깁니다. 이미 더 뒤까지 읽었으면 그대로 둡니다.
+	// 적용 후의 마지막 읽은 메시지 ID와, 실제로 앞으로 움직였는지를 돌려줍니다.
+	UpdateReadCursor(ctx context.Context, roomID, userID string, cursor *MessageCursor) (lastReadID string, advanced bool, err error)
+
+	// 멤버가 읽지 않은 메시지 수를 셉니다. (본인이 보낸 메시지 제외)
+	CountUnread(ctx context.Context, roomID, userID string) (int, error)
 }
 
 type chatPostgresRepository struct {
@@ -56,57 +149,254 @@ func NewChatRepository(dbPool *pgxpool.Pool) ChatRepository {
 	return &chatPostgresRepository{db: dbPool}
 }
 
-// EnsureRoomExists: rooms 테이블에 room_id가 없으면 삽입합니다.
-func (r *chatPostgresRepository) EnsureRoomExists(ctx context.Context, roomID, user1ID, user2ID string) error {
-	// 6글자 UUID 조합 ID 허용 (포맷 검사 로직 삭제됨)
+// EnsureDirectRoom: (user1_id, user2_id) 쌍으로 방을 찾고, 없으면 삽입합니다.
+// 두 아이디는 정렬해서 저장하므로 누가 먼저 요청하든 같은 방이 나옵니다.
+func (r *chatPostgresRepository) EnsureDirectRoom(ctx context.Context, userAID, userBID string) (string, error) {
+	user1ID, user2ID := userAID, userBID
+	if user1ID > user2ID {
+		user1ID, user2ID = user2ID, user1ID
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// DO UPDATE는 값이 바뀌지 않지만, 이미 있는 방의 room_id도 RETURNING으로 받기 위해 사용
 	const q = `
-        INSERT INTO rooms (room_id, user1_id, user2_id) VALUES ($1, $2, $3)
-        ON CONFLICT (room_id) DO NOTHING;
+        INSERT INTO rooms (room_id, user1_id, user2_id, room_type)
+        VALUES (gen_random_uuid()::text, $1, $2, 'direct')
+        ON CONFLICT (user1_id, user2_id) DO UPDATE SET user1_id = EXCLUDED.user1_id
+        RETURNING room_id;
     `
-	_, err := r.db.Exec(ctx, q, roomID, user1ID, user2ID)
-	if err != nil {
-		return fmt.Errorf("failed to ensure chat room existence: %w", err)
+	var roomID string
+	if err := tx.QueryRow(ctx, q, user1ID, user2ID).Scan(&roomID); err != nil {
+		return "", fmt.Errorf("failed to ensure chat room existence: %w", err)
+	}
+
+	if err := insertRoomMembers(ctx, tx, roomID, RoomRoleMember, []string{user1ID, user2ID}); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("failed to commit room: %w", err)
+	}
+	return roomID, nil
+}
+
+// insertRoomMembers: room_members에 여러 명을 한 번에 넣습니다. (이미 멤버면 무시)
+func insertRoomMembers(ctx context.Context, tx pgx.Tx, roomID, role string, userIDs []string) error {
+	const q = `
+        INSERT INTO room_members (room_id, user_id, role)
+        SELECT $1, unnest($2::text[]), $3
+        ON CONFLICT (room_id, user_id) DO NOTHING;
+    `
+	if _, err := tx.Exec(ctx, q, roomID, userIDs, role); err != nil {
+		return fmt.Errorf("failed to insert room members: %w", err)
 	}
 	return nil
 }
 
 // SaveMessage: 수신된 메시지를 messages 테이블에 저장합니다.
-func (r *chatPostgresRepository) SaveMessage(ctx context.Context, roomID, senderID, username, messageContent string) error {
+// 트랜잭션이 commit된 뒤에만 레코드를 돌려주므로, 에러가 없으면 기록(GetMessages)에도 반드시 남아 있습니다.
+func (r *chatPostgresRepository) SaveMessage(ctx context.Context, roomID, senderID, username, messageContent, clientMsgID string) (*MessageRecord, bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// 같은 (방, 보낸 사람, client_msg_id)가 이미 있으면 아무것도 하지 않음 (재전송 중복 방지)
+	// 멤버 확인을 INSERT에 같이 넣어서, 입장 후에 내보내진 멤버의 메시지는 저장되지 않게 함
 	const q = `
-        INSERT INTO messages (room_id, sender_id, username, message_content)
-        VALUES ($1, $2, $3, $4);
+        INSERT INTO messages (room_id, sender_id, username, message_content, client_msg_id)
+        SELECT $1, $2, $3, $4, $5
+        WHERE EXISTS (SELECT 1 FROM room_members WHERE room_id = $1 AND user_id = $3)
+        ON CONFLICT (room_id, sender_id, client_msg_id) WHERE client_msg_id IS NOT NULL DO NOTHING
+        RETURNING id, sent_at;
     `
-	_, err := r.db.Exec(ctx, q, roomID, senderID, username, messageContent)
+	var clientMsgIDPtr *string
+	if clientMsgID != "" {
+		clientMsgIDPtr = &clientMsgID
+	}
+
+	record := &MessageRecord{
+		RoomID:         roomID,
+		SenderID:       senderID,
+		Username:       username,
+		MessageContent: messageContent,
+		ClientMsgID:    clientMsgID,
+	}
+	duplicate := false
+	err = tx.QueryRow(ctx, q, roomID, senderID, username, messageContent, clientMsgIDPtr).Scan(&record.ID, &record.SentAt)
 	if err != nil {
-		return fmt.Errorf("failed to save chat message: %w", err)
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, false, fmt.Errorf("failed to save chat message: %w", err)
+		}
+
+		// 충돌 = 이미 저장된 메시지 → 기존 레코드를 돌려줌 (없으면 멤버가 아니라서 저장하지 않은 것)
+		const qExisting = `
+            SELECT ` + messageColumns + `
+            FROM messages
+            WHERE room_id = $1 AND sender_id = $2 AND client_msg_id = $3;
+        `
+		rows, err := tx.Query(ctx, qExisting, roomID, senderID, clientMsgID)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to load duplicate message: %w", err)
+		}
+		existing, err := scanMessageRecords(rows)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(existing) == 0 {
+			return nil, false, ErrNotRoomMember
+		}
+		record, duplicate = existing[0], true
 	}
-	return nil
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, false, fmt.Errorf("failed to commit chat message: %w", err)
+	}
+	return record, duplicate, nil
 }
 
-// GetMessagesByRoomID: 특정 방의 메시지 기록을 조회합니다.
-func (r *chatPostgresRepository) GetMessagesByRoomID(ctx context.Context, roomID string, limit int) ([]*MessageRecord, error) {
+// GetMessageByID 구현
+func (r *chatPostgresRepository) GetMessageByID(ctx context.Context, roomID, messageID string) (*MessageRecord, error) {
+	// UUID 형식이 아닌 ID는 "없는 메시지"로 취급
+	if !uuidPattern.MatchString(messageID) {
+		return nil, ErrMessageNotFound
+	}
+
 	const q = `
-        SELECT room_id, sender_id, username, message_content, sent_at 
+        SELECT ` + messageColumns + `
+        FROM messages
+        WHERE room_id = $1 AND id = $2::uuid;
+    `
+	rows, err := r.db.Query(ctx, q, roomID, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query message: %w", err)
+	}
+	records, err := scanMessageRecords(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, ErrMessageNotFound
+	}
+	return records[0], nil
+}
+
+// DeleteMessage 구현
+// 읽음 위치는 (sent_at, id) 값으로 비교하므로 읽음 위치가 가리키던 메시지를 지워도 안 읽은 수는 그대로 맞음
+func (r *chatPostgresRepository) DeleteMessage(ctx context.Context, roomID, messageID string) error {
+	if !uuidPattern.MatchString(messageID) {
+		return ErrMessageNotFound
+	}
+
+	const q = `DELETE FROM messages WHERE room_id = $1 AND id = $2::uuid`
+	tag, err := r.db.Exec(ctx, q, roomID, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to delete message: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrMessageNotFound
+	}
+	return nil
+}
+
+// GetMessagesBefore: (room_id, sent_at DESC) 인덱스를 역방향으로 훑어서 이전 페이지를 가져옵니다.
+func (r *chatPostgresRepository) GetMessagesBefore(ctx context.Context, roomID string, cursor *MessageCursor, limit int) ([]*MessageRecord, error) {
+	const qLatest = `
+        SELECT ` + messageColumns + `
+        FROM messages
+        WHERE room_id = $1
+        ORDER BY sent_at DESC, id DESC
+        LIMIT $2;
+    `
+	const qBefore = `
+        SELECT ` + messageColumns + `
+        FROM messages
+        WHERE room_id = $1 AND (sent_at, id) < ($3, $4::uuid)
+        ORDER BY sent_at DESC, id DESC
+        LIMIT $2;
+    `
+
+	var (
+		rows pgx.Rows
+		err  error
+	)
+	if cursor == nil {
+		rows, err = r.db.Query(ctx, qLatest, roomID, limit)
+	} else {
+		rows, err = r.db.Query(ctx, qBefore, roomID, limit, cursor.SentAt, cursor.ID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query messages: %w", err)
+	}
+
+	records, err := scanMessageRecords(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	// 최신 순으로 가져왔으니 오래된 순으로 뒤집기
+	for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+		records[i], records[j] = records[j], records[i]
+	}
+	return records, nil
+}
+
+// GetMessagesAfter: 같은 인덱스를 정방향으로 훑어서 다음 페이지를 가져옵니다.
+func (r *chatPostgresRepository) GetMessagesAfter(ctx context.Context, roomID string, cursor *MessageCursor, limit int) ([]*MessageRecord, error) {
+	const qOldest = `
+        SELECT ` + messageColumns + `
         FROM messages
         WHERE room_id = $1
-        ORDER BY sent_at ASC
+        ORDER BY sent_at ASC, id ASC
         LIMIT $2;
     `
-	rows, err := r.db.Query(ctx, q, roomID, limit)
+	const qAfter = `
+        SELECT ` + messageColumns + `
+        FROM messages
+        WHERE room_id = $1 AND (sent_at, id) > ($3, $4::uuid)
+        ORDER BY sent_at ASC, id ASC
+        LIMIT $2;
+    `
+
+	var (
+		rows pgx.Rows
+		err  error
+	)
+	if cursor == nil {
+		rows, err = r.db.Query(ctx, qOldest, roomID, limit)
+	} else {
+		rows, err = r.db.Query(ctx, qAfter, roomID, limit, cursor.SentAt, cursor.ID)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to query messages: %w", err)
 	}
+	return scanMessageRecords(rows)
+}
+
+// messageColumns: scanMessageRecords가 읽는 컬럼 순서
+const messageColumns = `id, room_id, sender_id, username, message_content, sent_at, COALESCE(client_msg_id, '')`
+
+// scanMessageRecords: 메시지 조회 결과를 MessageRecord 목록으로 변환합니다.
+func scanMessageRecords(rows pgx.Rows) ([]*MessageRecord, error) {
 	defer rows.Close()
 
 	var records []*MessageRecord
 	for rows.Next() {
 		record := &MessageRecord{}
 		err := rows.Scan(
+			&record.ID,
 			&record.RoomID,
 			&record.SenderID,
 			&record.Username,
 			&record.MessageContent,
 			&record.SentAt,
+			&record.ClientMsgID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan message row: %w", err)
@@ -149,16 +439,49 @@ func (r *chatPostgresRepository) GetUserInfo(ctx context.Context, username strin
 }
 
 // [추가] GetRoomsByUser 구현
-func (r *chatPostgresRepository) GetRoomsByUser(ctx context.Context, userID string) ([]*RoomInfoRecord, error) {
-	// 내가 user1이거나 user2인 모든 방을 찾는다. (최신 생성순)
+func (r *chatPostgresRepository) GetRoomsByUser(ctx context.Context, userID string, after *RoomCursor, limit int) ([]*RoomInfoRecord, error) {
+	// room_members 기준으로 내가 속한 모든 방(1:1 + 그룹)을 찾는다. (최근 활동순)
+	// 마지막 메시지는 LATERAL + (room_id, sent_at) 인덱스로 방마다 한 행만 읽고,
+	// 안 읽은 수도 읽음 위치 이후만 같은 인덱스로 센다. 한 번의 쿼리로 끝나므로 N+1 없음.
 	const q = `
-        SELECT room_id, user1_id, user2_id
-        FROM rooms
-        WHERE user1_id = $1 OR user2_id = $1
-        ORDER BY created_at DESC
+        SELECT * FROM (
+            SELECT r.room_id, COALESCE(r.user1_id, ''), COALESCE(r.user2_id, ''), r.room_type, r.title,
+                   (SELECT COUNT(*) FROM room_members c WHERE c.room_id = r.room_id),
+                   (SELECT COUNT(*) FROM messages msg
+                    WHERE msg.room_id = r.room_id AND msg.username <> m.user_id
+                      AND (m.last_read_sent_at IS NULL
+                           OR (msg.sent_at, msg.id) > (m.last_read_sent_at, m.last_read_message_id))),
+                   COALESCE(m.last_read_message_id::text, ''),
+                   COALESCE(ou.nickname, ''), COALESCE(ou.avatar_url, ''),
+                   COALESCE(lm.id::text, ''), COALESCE(LEFT(lm.message_content, $3), ''),
+                   COALESCE(lm.sender_id, ''), COALESCE(lm.username, ''), lm.sent_at,
+                   COALESCE(lm.sent_at, r.created_at, 'epoch'::timestamptz) AS last_activity_at
+            FROM room_members m
+            JOIN rooms r ON r.room_id = m.room_id
+            LEFT JOIN LATERAL (
+                SELECT id, message_content, sender_id, username, sent_at
+                FROM messages
+                WHERE room_id = r.room_id
+                ORDER BY sent_at DESC, id DESC
+                LIMIT 1
+            ) lm ON true
+            LEFT JOIN users ou ON r.room_type = 'direct'
+                AND ou.username = CASE WHEN r.user1_id = m.user_id THEN r.user2_id ELSE r.user1_id END
+            WHERE m.user_id = $1
+        ) t
+        WHERE $4::timestamptz IS NULL OR (last_activity_at, room_id) < ($4, $5)
+        ORDER BY last_activity_at DESC, room_id DESC
+        LIMIT $2
     `
 
-	rows, err := r.db.Query(ctx, q, userID)
+	var afterAt *time.Time
+	var afterID string
+	if after != nil {
+		afterAt = &after.LastActivityAt
+		afterID = after.RoomID
+	}
+
+	rows, err := r.db.Query(ctx, q, userID, limit, lastMessagePreviewLength, afterAt, afterID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query rooms: %w", err)
 	}
@@ -167,10 +490,195 @@ func (r *chatPostgresRepository) GetRoomsByUser(ctx context.Context, userID stri
 	var rooms []*RoomInfoRecord
 	for rows.Next() {
 		room := &RoomInfoRecord{}
-		if err := rows.Scan(&room.RoomID, &room.User1ID, &room.User2ID); err != nil {
+		var lastMessageAt *time.Time
+		if err := rows.Scan(
+			&room.RoomID, &room.User1ID, &room.User2ID, &room.RoomType, &room.Title, &room.MemberCount,
+			&room.UnreadCount, &room.LastReadMessageID,
+			&room.OtherNickname, &room.OtherAvatarURL,
+			&room.LastMessageID, &room.LastMessagePreview,
+			&room.LastMessageSenderID, &room.LastMessageUsername, &lastMessageAt,
+			&room.LastActivityAt,
+		); err != nil {
 			return nil, err
 		}
+		if lastMessageAt != nil {
+			room.LastMessageAt = *lastMessageAt
+		}
 		rooms = append(rooms, room)
 	}
-	return rooms, nil
+	return rooms, rows.Err()
+}
+
+// IsRoomMember 구현
+func (r *chatPostgresRepository) IsRoomMember(ctx context.Context, roomID, userID string) (bool, error) {
+	const q = `SELECT 1 FROM room_members WHERE room_id = $1 AND user_id = $2 LIMIT 1`
+	var dummy int
+	err := r.db.QueryRow(ctx, q, roomID, userID).Scan(&dummy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil // 방이 없거나 멤버가 아님
+		}
+		return false, fmt.Errorf("failed to check room membership: %w", err)
+	}
+	return true, nil
+}
+
+// GetRoom 구현
+func (r *chatPostgresRepository) GetRoom(ctx context.Context, roomID string) (*RoomInfoRecord, error) {
+	const q = `
+        SELECT r.room_id, COALESCE(r.user1_id, ''), COALESCE(r.user2_id, ''), r.room_type, r.title,
+               (SELECT COUNT(*) FROM room_members c WHERE c.room_id = r.room_id)
+        FROM rooms r
+        WHERE r.room_id = $1
+    `
+	room := &RoomInfoRecord{}
+	err := r.db.QueryRow(ctx, q, roomID).Scan(&room.RoomID, &room.User1ID, &room.User2ID, &room.RoomType, &room.Title, &room.MemberCount)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRoomNotFound
+		}
+		return nil, fmt.Errorf("failed to query room: %w", err)
+	}
+	return room, nil
+}
+
+// CreateGroupRoom 구현
+func (r *chatPostgresRepository) CreateGroupRoom(ctx context.Context, title, ownerID string, memberIDs []string) (string, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	const q = `
+        INSERT INTO rooms (room_id, room_type, title)
+        VALUES (gen_random_uuid()::text, 'group', $1)
+        RETURNING room_id;
+    `
+	var roomID string
+	if err := tx.QueryRow(ctx, q, title).Scan(&roomID); err != nil {
+		return "", fmt.Errorf("failed to create group room: %w", err)
+	}
+
+	if err := insertRoomMembers(ctx, tx, roomID, RoomRoleOwner, []string{ownerID}); err != nil {
+		return "", err
+	}
+	if err := insertRoomMembers(ctx, tx, roomID, RoomRoleMember, memberIDs); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("failed to commit group room: %w", err)
+	}
+	return roomID, nil
+}
+
+// AddRoomMembers 구현
+func (r *chatPostgresRepository) AddRoomMembers(ctx context.Context, roomID string, memberIDs []string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertRoomMembers(ctx, tx, roomID, RoomRoleMember, memberIDs); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// RemoveRoomMember 구현
+func (r *chatPostgresRepository) RemoveRoomMember(ctx context.Context, roomID, userID string) (bool, error) {
+	const q = `DELETE FROM room_members WHERE room_id = $1 AND user_id = $2`
+	tag, err := r.db.Exec(ctx, q, roomID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove room member: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// RenameRoom 구현
+func (r *chatPostgresRepository) RenameRoom(ctx context.Context, roomID, title string) error {
+	const q = `UPDATE rooms SET title = $1 WHERE room_id = $2`
+	tag, err := r.db.Exec(ctx, q, title, roomID)
+	if err != nil {
+		return fmt.Errorf("failed to rename room: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrRoomNotFound
+	}
+	return nil
+}
+
+// GetRoomMembers 구현
+func (r *chatPostgresRepository) GetRoomMembers(ctx context.Context, roomID string) ([]*RoomMemberRecord, error) {
+	const q = `
+        SELECT room_id, user_id, role, joined_at
+        FROM room_members
+        WHERE room_id = $1
+        ORDER BY joined_at ASC, user_id ASC
+    `
+	rows, err := r.db.Query(ctx, q, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query room members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []*RoomMemberRecord
+	for rows.Next() {
+		m := &RoomMemberRecord{}
+		if err := rows.Scan(&m.RoomID, &m.UserID, &m.Role, &m.JoinedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan room member row: %w", err)
+		}
+		members = append(members, m)
+	}
+	return members, rows.Err()
+}
+
+// UpdateReadCursor 구현
+func (r *chatPostgresRepository) UpdateReadCursor(ctx context.Context, roomID, userID string, cursor *MessageCursor) (string, bool, error) {
+	// 읽음 위치는 앞으로만 움직임
+	const qUpdate = `
+        UPDATE room_members
+        SET last_read_message_id = $3::uuid, last_read_sent_at = $4
+        WHERE room_id = $1 AND user_id = $2
+          AND (last_read_sent_at IS NULL OR (last_read_sent_at, last_read_message_id) < ($4, $3::uuid))
+        RETURNING last_read_message_id::text;
+    `
+	var lastReadID string
+	err := r.db.QueryRow(ctx, qUpdate, roomID, userID, cursor.ID, cursor.SentAt).Scan(&lastReadID)
+	if err == nil {
+		return lastReadID, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return "", false, fmt.Errorf("failed to update read cursor: %w", err)
+	}
+
+	// 움직이지 않았으면 현재 위치를 돌려줌
+	const qCurrent = `
+        SELECT COALESCE(last_read_message_id::text, '')
+        FROM room_members
+        WHERE room_id = $1 AND user_id = $2;
+    `
+	if err := r.db.QueryRow(ctx, qCurrent, roomID, userID).Scan(&lastReadID); err != nil {
+		return "", false, fmt.Errorf("failed to load read cursor: %w", err)
+	}
+	return lastReadID, false, nil
+}
+
+// CountUnread 구현
+func (r *chatPostgresRepository) CountUnread(ctx context.Context, roomID, userID string) (int, error) {
+	const q = `
+        SELECT COUNT(*)
+        FROM room_members m
+        JOIN messages msg ON msg.room_id = m.room_id
+        WHERE m.room_id = $1 AND m.user_id = $2 AND msg.username <> m.user_id
+          AND (m.last_read_sent_at IS NULL
+               OR (msg.sent_at, msg.id) > (m.last_read_sent_at, m.last_read_message_id));
+    `
+	var count int
+	if err := r.db.QueryRow(ctx, q, roomID, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count unread messages: %w", err)
+	}
+	return count, nil
 }
@@ -0,0 +1,318 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/db"
+)
+
+// testChatRepo: DATABASE_URL의 DB에 마이그레이션을 적용한 ChatRepository (없으면 테스트 건너뜀)
+func testChatRepo(t *testing.T) (ChatRepository, *pgxpool.Pool) {
+	t.Helper()
+	url := os.Getenv("DATABASE_URL")
+	if url == "" {
+		t.Skip("DATABASE_URL is not set")
+	}
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+	if err := db.ApplyMigrations(ctx, pool); err != nil {
+		t.Fatal(err)
+	}
+	return NewChatRepository(pool), pool
+}
+
+// testUsernames: 공유 DB에서도 겹치지 않는 유저를 prefix마다 하나씩 만들고, 끝나면 그 유저들의 방/메시지와 함께 지움
+func testUsernames(t *testing.T, pool *pgxpool.Pool, prefixes ...string) []string {
+	t.Helper()
+	ctx := context.Background()
+	suffix := make([]byte, 6)
+	rand.Read(suffix)
+
+	var names []string
+	for _, p := range prefixes {
+		name := p + "_" + hex.EncodeToString(suffix)
+		if _, err := pool.Exec(ctx,
+			`INSERT INTO users (username, name, email, password_hash) VALUES ($1, $1, $1 || '@example.test', 'x')`, name); err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, name)
+	}
+	t.Cleanup(func() {
+		ctx := context.Background()
+		const rooms = `SELECT room_id FROM rooms WHERE user1_id = ANY($1) OR user2_id = ANY($1)
+            UNION SELECT room_id FROM room_members WHERE user_id = ANY($1)`
+		pool.Exec(ctx, `DELETE FROM messages WHERE room_id IN (`+rooms+`)`, names)
+		pool.Exec(ctx, `DELETE FROM rooms WHERE room_id IN (`+rooms+`)`, names)
+		pool.Exec(ctx, `DELETE FROM users WHERE username = ANY($1)`, names)
+	})
+	return names
+}
+
+func TestIsRoomMember(t *testing.T) {
+	repo, pool := testChatRepo(t)
+	ctx := context.Background()
+	names := testUsernames(t, pool, "alice", "bob", "mallory")
+	alice, bob, mallory := names[0], names[1], names[2]
+
+	roomID, err := repo.EnsureDirectRoom(ctx, alice, bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		roomID, user string
+		want         bool
+	}{
+		{roomID, alice, true},
+		{roomID, bob, true},
+		{roomID, mallory, false},
+		{"00000000-0000-0000-0000-000000000000", alice, false},
+	}
+	for _, tt := range tests {
+		got, err := repo.IsRoomMember(ctx, tt.roomID, tt.user)
+		if err != nil || got != tt.want {
+			t.Errorf("IsRoomMember(%s, %s) = %v, %v, want %v", tt.roomID, tt.user, got, err, tt.want)
+		}
+	}
+}
+
+func TestGroupRoomMembership(t *testing.T) {
+	repo, pool := testChatRepo(t)
+	ctx := context.Background()
+	names := testUsernames(t, pool, "alice", "bob", "carol")
+	alice, bob, carol := names[0], names[1], names[2]
+
+	roomID, err := repo.CreateGroupRoom(ctx, "team", alice, []string{bob})
+	if err != nil {
+		t.Fatal(err)
+	}
+	room, err := repo.GetRoom(ctx, roomID)
+	if err != nil || room.RoomType != RoomTypeGroup || room.Title != "team" || room.MemberCount != 2 {
+		t.Fatalf("GetRoom = %+v, %v; want the group with 2 members", room, err)
+	}
+
+	// 이미 멤버인 유저는 다시 넣어도 그대로
+	if err := repo.AddRoomMembers(ctx, roomID, []string{bob, carol}); err != nil {
+		t.Fatal(err)
+	}
+	members, err := repo.GetRoomMembers(ctx, roomID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roles := map[string]string{}
+	for _, m := range members {
+		roles[m.UserID] = m.Role
+	}
+	if len(roles) != 3 || roles[alice] != RoomRoleOwner || roles[bob] != RoomRoleMember || roles[carol] != RoomRoleMember {
+		t.Fatalf("members = %v, want alice as owner plus bob and carol", roles)
+	}
+
+	if removed, err := repo.RemoveRoomMember(ctx, roomID, bob); err != nil || !removed {
+		t.Fatalf("RemoveRoomMember = %v, %v", removed, err)
+	}
+	if removed, err := repo.RemoveRoomMember(ctx, roomID, bob); err != nil || removed {
+		t.Fatalf("second RemoveRoomMember = %v, %v; want false", removed, err)
+	}
+
+	// 내보내진 멤버의 메시지는 저장되지 않음
+	if _, _, err := repo.SaveMessage(ctx, roomID, "id-"+bob, bob, "still here?", "bob-1"); err != ErrNotRoomMember {
+		t.Fatalf("SaveMessage by removed member: err = %v, want ErrNotRoomMember", err)
+	}
+	if _, _, err := repo.SaveMessage(ctx, roomID, "id-"+carol, carol, "hi", "carol-1"); err != nil {
+		t.Fatalf("SaveMessage by member: %v", err)
+	}
+}
+
+func TestSaveMessageAssignsServerFields(t *testing.T) {
+	repo, pool := testChatRepo(t)
+	ctx := context.Background()
+	names := testUsernames(t, pool, "alice", "bob")
+	alice, bob := names[0], names[1]
+
+	roomID, err := repo.EnsureDirectRoom(ctx, alice, bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := time.Now().Add(-time.Minute)
+	saved, duplicate, err := repo.SaveMessage(ctx, roomID, "id-"+alice, alice, "hi", "c1")
+	if err != nil || duplicate {
+		t.Fatalf("SaveMessage = %v, %v", duplicate, err)
+	}
+	if !uuidPattern.MatchString(saved.ID) || saved.SentAt.Before(before) || saved.SenderID != "id-"+alice || saved.ClientMsgID != "c1" {
+		t.Fatalf("saved = %+v, want a UUID, the server time and the sender ID", saved)
+	}
+
+	// 같은 client_message_id로 다시 보내면 처음 저장된 레코드가 그대로 나옴
+	again, duplicate, err := repo.SaveMessage(ctx, roomID, "id-"+alice, alice, "hi", "c1")
+	if err != nil || !duplicate || again.ID != saved.ID || !again.SentAt.Equal(saved.SentAt) {
+		t.Fatalf("resend = %+v, %v, %v; want the first record as a duplicate", again, duplicate, err)
+	}
+
+	stored, err := repo.GetMessageByID(ctx, roomID, saved.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.SenderID != saved.SenderID || stored.Username != alice || !stored.SentAt.Equal(saved.SentAt) {
+		t.Fatalf("GetMessageByID = %+v, want %+v", stored, saved)
+	}
+}
+
+func TestUnreadCountFollowsReadCursor(t *testing.T) {
+	repo, pool := testChatRepo(t)
+	ctx := context.Background()
+	names := testUsernames(t, pool, "alice", "bob")
+	alice, bob := names[0], names[1]
+
+	roomID, err := repo.EnsureDirectRoom(ctx, alice, bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved []*MessageRecord
+	for _, text := range []string{"one", "two", "three"} {
+		m, _, err := repo.SaveMessage(ctx, roomID, "id-"+bob, bob, text, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		saved = append(saved, m)
+	}
+	// 내가 보낸 메시지는 안 읽은 수에 들어가지 않음
+	if _, _, err := repo.SaveMessage(ctx, roomID, "id-"+alice, alice, "mine", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// 순서대로 실행 (읽음 위치는 앞으로만 움직임)
+	tests := []struct {
+		name         string
+		readUpTo     *MessageRecord
+		wantAdvanced bool
+		wantLastRead string
+		wantUnread   int
+	}{
+		{"nothing read yet", nil, false, "", 3},
+		{"read the second", saved[1], true, saved[1].ID, 1},
+		{"older message", saved[0], false, saved[1].ID, 1},
+		{"read the last", saved[2], true, saved[2].ID, 0},
+	}
+	for _, tt := range tests {
+		if tt.readUpTo != nil {
+			lastRead, advanced, err := repo.UpdateReadCursor(ctx, roomID, alice, CursorOf(tt.readUpTo))
+			if err != nil || advanced != tt.wantAdvanced || lastRead != tt.wantLastRead {
+				t.Fatalf("%s: UpdateReadCursor = %s, %v, %v; want %s, %v", tt.name, lastRead, advanced, err, tt.wantLastRead, tt.wantAdvanced)
+			}
+		}
+		if unread, err := repo.CountUnread(ctx, roomID, alice); err != nil || unread != tt.wantUnread {
+			t.Errorf("%s: CountUnread = %d, %v; want %d", tt.name, unread, err, tt.wantUnread)
+		}
+	}
+}
+
+func TestGetRoomsByUserOrdersByLastActivity(t *testing.T) {
+	repo, pool := testChatRepo(t)
+	ctx := context.Background()
+	names := testUsernames(t, pool, "alice", "bob", "carol", "dave")
+	alice, bob, carol, dave := names[0], names[1], names[2], names[3]
+	if _, err := pool.Exec(ctx, `UPDATE users SET nickname = 'Bobby' WHERE username = $1`, bob); err != nil {
+		t.Fatal(err)
+	}
+
+	// 가장 먼저 만든 bob 방에 메시지가 오면 새로 만든 빈 방들보다 앞에 나옴
+	var rooms []string
+	for _, other := range []string{bob, carol, dave} {
+		roomID, err := repo.EnsureDirectRoom(ctx, alice, other)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rooms = append(rooms, roomID)
+	}
+	if _, _, err := repo.SaveMessage(ctx, rooms[0], "id-"+bob, bob, "hello again", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []*RoomInfoRecord
+	var after *RoomCursor
+	for {
+		page, err := repo.GetRoomsByUser(ctx, alice, after, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, page...)
+		if len(page) < 2 {
+			break
+		}
+		after = RoomCursorOf(page[len(page)-1])
+	}
+
+	want := []string{rooms[0], rooms[2], rooms[1]}
+	if len(got) != len(want) {
+		t.Fatalf("got %d rooms, want %d", len(got), len(want))
+	}
+	for i, r := range got {
+		if r.RoomID != want[i] {
+			t.Fatalf("room %d = %s, want %s", i, r.RoomID, want[i])
+		}
+	}
+	first := got[0]
+	if first.LastMessagePreview != "hello again" || first.LastMessageUsername != bob || first.OtherNickname != "Bobby" || first.UnreadCount != 1 {
+		t.Fatalf("first room = %+v, want bob's preview, nickname and one unread", first)
+	}
+}
+
+// 삭제한 유저와 같은 username으로 다시 가입해도 예전 1:1 대화를 이어받지 않아야 함
+func TestDeleteUserDetachesDirectRooms(t *testing.T) {
+	repo, pool := testChatRepo(t)
+	ctx := context.Background()
+	names := testUsernames(t, pool, "alice", "bob")
+	alice, bob := names[0], names[1]
+
+	oldRoom, err := repo.EnsureDirectRoom(ctx, alice, bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := repo.SaveMessage(ctx, oldRoom, "id-"+bob, bob, "secret", ""); err != nil {
+		t.Fatal(err)
+	}
+	var bobID string
+	if err := pool.QueryRow(ctx, `SELECT id FROM users WHERE username = $1`, bob).Scan(&bobID); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&service{db: pool}).DeleteUser(ctx, bobID); err != nil {
+		t.Fatal(err)
+	}
+
+	// 같은 username으로 다시 가입
+	if _, err := pool.Exec(ctx,
+		`INSERT INTO users (username, name, email, password_hash) VALUES ($1, $1, $1 || '@example.test', 'x')`, bob); err != nil {
+		t.Fatal(err)
+	}
+	newRoom, err := repo.EnsureDirectRoom(ctx, alice, bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newRoom == oldRoom {
+		t.Fatalf("re-registered %s got the deleted user's room %s", bob, oldRoom)
+	}
+	if isMember, err := repo.IsRoomMember(ctx, oldRoom, bob); err != nil || isMember {
+		t.Fatalf("IsRoomMember(old room, %s) = %v, %v; want false", bob, isMember, err)
+	}
+	if history, err := repo.GetMessagesAfter(ctx, newRoom, nil, 10); err != nil || len(history) != 0 {
+		t.Fatalf("new room history = %v, %v; want empty", history, err)
+	}
+
+	// 남은 상대방에게는 예전 대화가 그대로 남음
+	if isMember, err := repo.IsRoomMember(ctx, oldRoom, alice); err != nil || !isMember {
+		t.Fatalf("IsRoomMember(old room, %s) = %v, %v; want true", alice, isMember, err)
+	}
+	if history, err := repo.GetMessagesAfter(ctx, oldRoom, nil, 10); err != nil || len(history) != 1 {
+		t.Fatalf("old room history = %v, %v; want the deleted user's message", history, err)
+	}
+}
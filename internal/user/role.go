@@ -0,0 +1,40 @@
+package user
+
+import "errors"
+
+// Role: 유저 역할 (users.role, access token의 role 클레임)
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator" // 계정 차단/강제 로그아웃, 방/메시지 관리
+	RoleAdmin     Role = "admin"     // 모든 관리자 작업 (역할 변경, 삭제, 비밀번호 초기화, 감사 기록 조회)
+)
+
+var ErrInvalidRole = errors.New("invalid role (user, moderator, admin)")
+
+// roleRank: 높을수록 권한이 많음 (모르는 값은 0이라 아무 권한도 없음)
+var roleRank = map[Role]int{
+	RoleUser:      1,
+	RoleModerator: 2,
+	RoleAdmin:     3,
+}
+
+// ParseRole: 문자열 → Role (모르는 역할이면 ErrInvalidRole)
+func ParseRole(s string) (Role, error) {
+	r := Role(s)
+	if _, ok := roleRank[r]; !ok {
+		return "", ErrInvalidRole
+	}
+	return r, nil
+}
+
+// AtLeast: r이 min 이상의 권한인지
+func (r Role) AtLeast(min Role) bool {
+	return roleRank[r] >= roleRank[min]
+}
+
+// Outranks: r이 other보다 높은 권한인지 (중재자가 다른 중재자/관리자를 건드리지 못하게 할 때)
+func (r Role) Outranks(other Role) bool {
+	return roleRank[r] > roleRank[other]
+}
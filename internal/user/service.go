@@ -15,6 +15,8 @@ import (
 var (
 	ErrUsernameTaken = errors.New("username already taken")
 	ErrEmailTaken    = errors.New("email already taken")
+	ErrUserDisabled  = errors.New("account is disabled")
+	ErrUserNotFound  = errors.New("user not found")
 )
 
 // ---------------------
@@ -27,16 +29,34 @@ type Service interface {
 	RequestPhoneVerification(ctx context.Context, phone string) (string, error)
 	VerifyPhone(ctx context.Context, verificationID, code string) (bool, error)
 
-	SignUp(ctx context.Context, username, name, phone, email, password string) (*User, error)
+	// phoneVerificationID: VerifyPhone으로 인증을 마친 인증 ID (있으면 한 번 쓰고 phone_verified로 가입)
+	SignUp(ctx context.Context, username, name, phone, email, password, phoneVerificationID string) (*User, error)
 	Login(ctx context.Context, username, password string) (*User, error)
 	SocialLogin(ctx context.Context, provider userpb.SocialProvider, accessToken string) (*User, error)
 
+	LinkSocialAccount(ctx context.Context, userID string, provider userpb.SocialProvider, accessToken string) ([]*Identity, error)
+	UnlinkSocialAccount(ctx context.Context, userID string, provider userpb.SocialProvider) ([]*Identity, error)
+	ListSocialAccounts(ctx context.Context, userID string) ([]*Identity, error)
+
+	IssueTokens(ctx context.Context, u *User) (*TokenPair, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error)
+	Logout(ctx context.Context, userID, sessionID string) error
+	LogoutAllDevices(ctx context.Context, userID string) (int64, error)
+
 	GetProfile(ctx context.Context, userID string) (*User, error)
-	UpdateProfile(ctx context.Context, userID, name, nickname, phone, email string) (*User, error)
+	UpdateProfile(ctx context.Context, userID, name, nickname, phone, email, phoneVerificationID string) (*User, error)
 	ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) error
 	UpdateAvatar(ctx context.Context, userID, avatarURL string) (*User, error)
 
 	SearchUsers(ctx context.Context, query string, limit, offset int32) ([]*User, error)
+
+	// 관리자 (AdminService)
+	ListUsers(ctx context.Context, limit, offset int32) ([]*User, error)
+	SetUserDisabled(ctx context.Context, userID string, disabled bool) (*User, error)
+	DeleteUser(ctx context.Context, userID string) error
+	ResetPassword(ctx context.Context, userID, newPassword string) (temporaryPassword string, err error)
+	SetUserRole(ctx context.Context, userID string, role Role) (*User, error)
+	ForceLogout(ctx context.Context, userID string) (revokedSessions int64, err error)
 }
 
 // ---------------------------
@@ -44,18 +64,20 @@ type Service interface {
 // ---------------------------
 
 type service struct {
-	db *pgxpool.Pool
+	db     *pgxpool.Pool
+	phone  *PhoneVerifier
+	social IdentityProviders
 }
 
-func NewService(db *pgxpool.Pool) Service {
-	return &service{db: db}
+func NewService(db *pgxpool.Pool, phone *PhoneVerifier, social IdentityProviders) Service {
+	return &service{db: db, phone: phone, social: social}
 }
 
 // ---------------------------
 // SignUp 실제 구현
 // ---------------------------
 
-func (s *service) SignUp(ctx context.Context, username, name, phone, email, password string) (*User, error) {
+func (s *service) SignUp(ctx context.Context, username, name, phone, email, password, phoneVerificationID string) (*User, error) {
 	// 1. 비밀번호 bcrypt
 	hashed, err := bcrypt.GenerateFromPassword([]byte(password), 12)
 	if err != nil {
@@ -71,23 +93,40 @@ func (s *service) SignUp(ctx context.Context, username, name, phone, email, pass
 		phonePtr = &phone
 	}
 
-	// 4. INSERT + RETURNING
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// 4. 인증 ID를 보냈으면 이 번호의 인증을 한 번 쓰고 phone_verified = true로 가입
+	//    (가입이 실패하면 rollback되어 인증을 다시 쓸 수 있음)
+	phoneVerified := false
+	if phoneVerificationID != "" {
+		if err := consumePhoneVerification(ctx, tx, phoneVerificationID, phone); err != nil {
+			return nil, err
+		}
+		phoneVerified = true
+	}
+
+	// 5. INSERT + RETURNING
 	const q = `
-		INSERT INTO users (username, name, phone, email, password_hash, nickname)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO users (username, name, phone, email, password_hash, nickname, phone_verified)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		RETURNING id, username, name, phone, phone_verified,
 		          email, password_hash, nickname, avatar_url,
 		          created_at, updated_at
 	`
 
 	var u User
-	err = s.db.QueryRow(ctx, q,
+	err = tx.QueryRow(ctx, q,
 		username,
 		name,
 		phonePtr,
 		email,
 		string(hashed),
 		nickname,
+		phoneVerified,
 	).Scan(
 		&u.ID,
 		&u.Username, //Id
@@ -115,6 +154,9 @@ func (s *service) SignUp(ctx context.Context, username, name, phone, email, pass
 		return nil, err
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
 	return &u, nil
 }
 
@@ -170,11 +212,11 @@ func (s *service) CheckEmail(ctx context.Context, email string) (bool, error) {
 }
 
 func (s *service) RequestPhoneVerification(ctx context.Context, phone string) (string, error) {
-	return "", errors.New("not implemented yet")
+	return s.phone.Request(ctx, phone)
 }
 
 func (s *service) VerifyPhone(ctx context.Context, verificationID, code string) (bool, error) {
-	return false, errors.New("not implemented yet")
+	return s.phone.Verify(ctx, verificationID, code)
 }
 
 func (s *service) Login(ctx context.Context, username, password string) (*User, error) {
@@ -182,7 +224,7 @@ func (s *service) Login(ctx context.Context, username, password string) (*User,
 	const q = `
 		SELECT id, username, name, phone, phone_verified,
 		       email, password_hash, nickname, avatar_url,
-		       created_at, updated_at
+		       created_at, updated_at, disabled_at, role
 		FROM users
 		WHERE username = $1
 	`
@@ -200,6 +242,8 @@ func (s *service) Login(ctx context.Context, username, password string) (*User,
 		&u.AvatarURL,
 		&u.CreatedAt,
 		&u.UpdatedAt,
+		&u.DisabledAt,
+		&u.Role,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -218,17 +262,18 @@ func (s *service) Login(ctx context.Context, username, password string) (*User,
 		return nil, errors.New("invalid username or password")
 	}
 
-	return &u, nil
-}
+	// 3. 관리자가 차단한 계정 (비밀번호가 맞을 때만 알려줌)
+	if u.DisabledAt != nil {
+		return nil, ErrUserDisabled
+	}
 
-func (s *service) SocialLogin(ctx context.Context, provider userpb.SocialProvider, accessToken string) (*User, error) {
-	return nil, errors.New("not implemented yet")
+	return &u, nil
 }
 
 func (s *service) GetProfile(ctx context.Context, userID string) (*User, error) {
 	const q = `
 		SELECT id, username, name, phone, phone_verified,
-		       email, nickname, avatar_url, created_at
+		       email, nickname, avatar_url, created_at, disabled_at, role
 		FROM users
 		WHERE id = $1
 	`
@@ -244,10 +289,12 @@ func (s *service) GetProfile(ctx context.Context, userID string) (*User, error)
 		&u.Nickname,
 		&u.AvatarURL,
 		&u.CreatedAt,
+		&u.DisabledAt,
+		&u.Role,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errors.New("user not found")
+			return nil, ErrUserNotFound
 		}
 		return nil, err
 	}
@@ -255,7 +302,7 @@ func (s *service) GetProfile(ctx context.Context, userID string) (*User, error)
 	return &u, nil
 }
 
-func (s *service) UpdateProfile(ctx context.Context, userID, name, nickname, phone, email string) (*User, error) {
+func (s *service) UpdateProfile(ctx context.Context, userID, name, nickname, phone, email, phoneVerificationID string) (*User, error) {
 	var pName, pNickname, pPhone, pEmail *string
 
 	if name != "" {
@@ -271,11 +318,46 @@ func (s *service) UpdateProfile(ctx context.Context, userID, name, nickname, pho
 		pEmail = &email
 	}
 
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// 인증 ID를 보냈으면 바꿀 번호(번호를 안 바꾸면 지금 번호)의 인증을 한 번 쓰고 이 유저만 phone_verified로
+	phoneVerified := false
+	if phoneVerificationID != "" {
+		verifiedPhone := phone
+		if verifiedPhone == "" {
+			var current *string
+			if err := tx.QueryRow(ctx, `SELECT phone FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&current); err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return nil, ErrUserNotFound
+				}
+				return nil, err
+			}
+			if current == nil {
+				return nil, ErrVerificationNotUsable
+			}
+			verifiedPhone = *current
+		}
+		if err := consumePhoneVerification(ctx, tx, phoneVerificationID, verifiedPhone); err != nil {
+			return nil, err
+		}
+		phoneVerified = true
+	}
+
+	// 인증 없이 번호를 바꾸면 phone_verified 해제
 	const q = `
         UPDATE users
         SET
             name     = COALESCE($1, name),
             nickname = COALESCE($2, nickname),
+            phone_verified = CASE
+                WHEN $6::boolean THEN true
+                WHEN $3::text IS NULL OR $3 = phone THEN phone_verified
+                ELSE false
+            END,
             phone    = COALESCE($3, phone),
             email    = COALESCE($4, email)
         WHERE id = $5
@@ -284,12 +366,13 @@ func (s *service) UpdateProfile(ctx context.Context, userID, name, nickname, pho
     `
 
 	var u User
-	err := s.db.QueryRow(ctx, q,
+	err = tx.QueryRow(ctx, q,
 		pName,
 		pNickname,
 		pPhone,
 		pEmail,
 		userID,
+		phoneVerified,
 	).Scan(
 		&u.ID,
 		&u.Username,
@@ -303,11 +386,14 @@ func (s *service) UpdateProfile(ctx context.Context, userID, name, nickname, pho
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errors.New("user not found")
+			return nil, ErrUserNotFound
 		}
 		return nil, err
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
 	return &u, nil
 }
 
@@ -322,7 +408,7 @@ func (s *service) ChangePassword(ctx context.Context, userID, currentPassword, n
 	var storedHash string
 	if err := s.db.QueryRow(ctx, qSelect, userID).Scan(&storedHash); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return errors.New("user not found")
+			return ErrUserNotFound
 		}
 		return err
 	}
@@ -371,7 +457,7 @@ func (s *service) UpdateAvatar(ctx context.Context, userID, avatarURL string) (*
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errors.New("user not found")
+			return nil, ErrUserNotFound
 		}
 		return nil, err
 	}
@@ -396,7 +482,7 @@ func (s *service) SearchUsers(ctx context.Context, query string, limit, offset i
 
 	const q = `
 		SELECT id, username, name, phone, phone_verified,
-		       email, nickname, avatar_url, created_at
+		       email, nickname, avatar_url, created_at, disabled_at, role
 		FROM users
 		WHERE username ILIKE $1
 		   OR nickname ILIKE $1
@@ -426,6 +512,8 @@ func (s *service) SearchUsers(ctx context.Context, query string, limit, offset i
 			&u.Nickname,
 			&u.AvatarURL,
 			&u.CreatedAt,
+			&u.DisabledAt,
+			&u.Role,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,228 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	// ErrRefreshTokenReused: 이미 교체된 refresh token이 다시 들어옴 (탈취 의심, 세션 전체를 끊음)
+	ErrRefreshTokenReused = errors.New("refresh token reuse detected")
+)
+
+// 세션을 끊은 이유 (auth_sessions.revoke_reason)
+const (
+	revokeReasonLogout    = "logout"
+	revokeReasonLogoutAll = "logout_all"
+	revokeReasonReuse     = "refresh_token_reuse"
+)
+
+// TokenPair: 로그인/갱신 시 발급하는 토큰 한 쌍
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	SessionID    string
+}
+
+// NewSessionChecker: 인터셉터용 세션 상태 확인 (ConfigureSessions에 넘김)
+func NewSessionChecker(db *pgxpool.Pool) SessionChecker {
+	return &service{db: db}
+}
+
+// newRefreshToken: 불투명 refresh token 원문과 DB에 저장할 해시
+func newRefreshToken() (raw, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	raw = base64.RawURLEncoding.EncodeToString(b)
+	return raw, hashRefreshToken(raw), nil
+}
+
+func hashRefreshToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// insertRefreshToken: 세션에 새 refresh token을 저장하고 원문을 돌려줌
+func insertRefreshToken(ctx context.Context, tx pgx.Tx, sessionID string) (string, error) {
+	raw, hash, err := newRefreshToken()
+	if err != nil {
+		return "", err
+	}
+
+	const q = `
+		INSERT INTO refresh_tokens (token_hash, session_id, expires_at)
+		VALUES ($1, $2, $3)
+	`
+	if _, err := tx.Exec(ctx, q, hash, sessionID, time.Now().Add(refreshTokenTTL)); err != nil {
+		return "", err
+	}
+	return raw, nil
+}
+
+// IssueTokens: 로그인 성공 시 새 세션을 만들고 access / refresh token을 발급
+func (s *service) IssueTokens(ctx context.Context, u *User) (*TokenPair, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var sessionID string
+	if err := tx.QueryRow(ctx, `INSERT INTO auth_sessions (user_id) VALUES ($1) RETURNING id`, u.ID).Scan(&sessionID); err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := insertRefreshToken(ctx, tx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	accessToken, err := GenerateAccessToken(u, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{AccessToken: accessToken, RefreshToken: refreshToken, SessionID: sessionID}, nil
+}
+
+// RefreshToken: refresh token을 새 토큰 쌍으로 교체 (rotation)
+// 이미 교체된 토큰이 다시 들어오면 탈취로 보고 그 세션(토큰 계열 전체)을 끊고 ErrRefreshTokenReused를 돌려줌
+func (s *service) RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	if refreshToken == "" {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// 같은 토큰으로 동시에 갱신하면 한쪽만 성공하도록 행을 잠금
+	const qSelect = `
+		SELECT rt.session_id, rt.expires_at, rt.used_at, s.revoked_at,
+		       u.id, u.username, u.role
+		FROM refresh_tokens rt
+		JOIN auth_sessions s ON s.id = rt.session_id
+		JOIN users u ON u.id = s.user_id
+		WHERE rt.token_hash = $1
+		FOR UPDATE OF rt, s
+	`
+
+	var (
+		sessionID string
+		expiresAt time.Time
+		usedAt    *time.Time
+		revokedAt *time.Time
+		u         User
+	)
+	err = tx.QueryRow(ctx, qSelect, hashRefreshToken(refreshToken)).Scan(
+		&sessionID, &expiresAt, &usedAt, &revokedAt, &u.ID, &u.Username, &u.Role,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	if revokedAt != nil {
+		return nil, ErrInvalidRefreshToken
+	}
+	if usedAt != nil {
+		// 재사용 감지: 세션을 끊은 것까지는 커밋해야 함
+		if err := revokeSession(ctx, tx, sessionID, revokeReasonReuse); err != nil {
+			return nil, err
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
+	}
+	if time.Now().After(expiresAt) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE refresh_tokens SET used_at = now() WHERE token_hash = $1`, hashRefreshToken(refreshToken)); err != nil {
+		return nil, err
+	}
+	newRefresh, err := insertRefreshToken(ctx, tx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	accessToken, err := GenerateAccessToken(&u, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{AccessToken: accessToken, RefreshToken: newRefresh, SessionID: sessionID}, nil
+}
+
+// revokeSession: 세션을 끊음 (이미 끊긴 세션은 그대로 둠)
+func revokeSession(ctx context.Context, tx pgx.Tx, sessionID, reason string) error {
+	const q = `
+		UPDATE auth_sessions
+		SET revoked_at = now(), revoke_reason = $2
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+	_, err := tx.Exec(ctx, q, sessionID, reason)
+	return err
+}
+
+// Logout: userID의 세션 하나를 끊음 (그 세션의 access / refresh token 모두 거부됨)
+func (s *service) Logout(ctx context.Context, userID, sessionID string) error {
+	const q = `
+		UPDATE auth_sessions
+		SET revoked_at = now(), revoke_reason = $3
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+	`
+	_, err := s.db.Exec(ctx, q, sessionID, userID, revokeReasonLogout)
+	return err
+}
+
+// LogoutAllDevices: userID의 살아 있는 세션을 모두 끊고 끊은 수를 돌려줌
+func (s *service) LogoutAllDevices(ctx context.Context, userID string) (int64, error) {
+	const q = `
+		UPDATE auth_sessions
+		SET revoked_at = now(), revoke_reason = $2
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`
+	tag, err := s.db.Exec(ctx, q, userID, revokeReasonLogoutAll)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// SessionActive: 세션이 있고 끊기지 않았는지 확인
+func (s *service) SessionActive(ctx context.Context, sessionID string) (bool, error) {
+	if !uuidPattern.MatchString(sessionID) {
+		return false, nil
+	}
+
+	var revoked bool
+	err := s.db.QueryRow(ctx, `SELECT revoked_at IS NOT NULL FROM auth_sessions WHERE id = $1`, sessionID).Scan(&revoked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return !revoked, nil
+}
@@ -0,0 +1,83 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+)
+
+// SMSSender: 문자 발송 (실제 발송 업체 연동은 이 인터페이스를 구현해서 NewSMSSender에 추가)
+type SMSSender interface {
+	Send(ctx context.Context, phone, message string) error
+}
+
+// ErrSMSNotConfigured: phone.sms_sender=none (전화번호 인증을 쓰지 않음)
+var ErrSMSNotConfigured = errors.New("sms sender is not configured")
+
+// NewSMSSender: 설정(phone.sms_sender)에 맞는 발송기
+// log / file은 인증번호를 평문으로 남기므로 phone.dev_sms가 켜져 있을 때만 만듦
+func NewSMSSender(cfg config.PhoneConfig) (SMSSender, error) {
+	switch cfg.SMSSender {
+	case "none", "":
+		return noSMSSender{}, nil
+	case "log", "file":
+		if !cfg.DevSMS {
+			return nil, fmt.Errorf("sms sender %q writes verification codes in plain text and needs phone.dev_sms", cfg.SMSSender)
+		}
+		if cfg.SMSSender == "file" {
+			return NewFileSMSSender(cfg.SMSFile), nil
+		}
+		return LogSMSSender{}, nil
+	default:
+		return nil, fmt.Errorf("unknown sms sender %q", cfg.SMSSender)
+	}
+}
+
+// noSMSSender: 발송기가 없을 때 (인증번호 요청은 ErrSMSNotConfigured로 실패)
+type noSMSSender struct{}
+
+func (noSMSSender) Send(ctx context.Context, phone, message string) error {
+	return ErrSMSNotConfigured
+}
+
+// LogSMSSender: 문자를 서버 로그로만 남김 (개발용)
+type LogSMSSender struct{}
+
+func (LogSMSSender) Send(ctx context.Context, phone, message string) error {
+	log.Printf("[SMS] to %s: %s", phone, message)
+	return nil
+}
+
+// FileSMSSender: 문자를 파일에 한 줄씩 덧붙임 (오프라인 테스트/로컬 개발용)
+// 형식: RFC3339 시각 \t 번호 \t 내용
+type FileSMSSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSMSSender(path string) *FileSMSSender {
+	return &FileSMSSender{path: path}
+}
+
+func (f *FileSMSSender) Send(ctx context.Context, phone, message string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	line := strings.Join([]string{time.Now().Format(time.RFC3339), phone, strings.ReplaceAll(message, "\n", " ")}, "\t")
+	if _, err := fmt.Fprintln(file, line); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
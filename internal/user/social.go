@@ -0,0 +1,206 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/userpb"
+)
+
+var (
+	ErrUnsupportedProvider = errors.New("unsupported social provider")
+	ErrInvalidSocialToken  = errors.New("invalid social access token")
+)
+
+// SocialProfile: 제공자가 확인해 준 사용자 정보
+type SocialProfile struct {
+	Provider       userpb.SocialProvider
+	ProviderUserID string // 제공자 회원번호 (user_identities.provider_user_id)
+	Email          string
+	EmailVerified  bool
+	Nickname       string
+	AvatarURL      string
+}
+
+// IdentityProvider: 클라이언트가 받아 온 제공자 access token으로 사용자 정보를 조회
+// 토큰이 틀리거나 만료됐거나 이 서비스가 아닌 다른 앱에 발급된 토큰이면 ErrInvalidSocialToken
+type IdentityProvider interface {
+	FetchProfile(ctx context.Context, accessToken string) (*SocialProfile, error)
+}
+
+// IdentityProviders: 제공자별 구현
+type IdentityProviders map[userpb.SocialProvider]IdentityProvider
+
+// NewIdentityProviders: 설정(social.*)의 API 주소로 카카오/네이버 구현을 만듦
+// 앱 ID(social.kakao_app_id / naver_client_id)를 설정한 제공자만 사용 (나머지는 ErrUnsupportedProvider)
+func NewIdentityProviders(cfg config.SocialConfig) IdentityProviders {
+	client := &http.Client{Timeout: cfg.Timeout}
+	providers := IdentityProviders{}
+	if cfg.KakaoAppID != "" {
+		providers[userpb.SocialProvider_SOCIAL_PROVIDER_KAKAO] = &kakaoProvider{baseURL: strings.TrimRight(cfg.KakaoAPIURL, "/"), appID: cfg.KakaoAppID, client: client}
+	}
+	if cfg.NaverClientID != "" {
+		providers[userpb.SocialProvider_SOCIAL_PROVIDER_NAVER] = &naverProvider{baseURL: strings.TrimRight(cfg.NaverAPIURL, "/"), clientID: cfg.NaverClientID, client: client}
+	}
+	return providers
+}
+
+// providerName: DB(user_identities.provider)에 저장하는 제공자 이름
+func providerName(p userpb.SocialProvider) (string, error) {
+	switch p {
+	case userpb.SocialProvider_SOCIAL_PROVIDER_KAKAO:
+		return "kakao", nil
+	case userpb.SocialProvider_SOCIAL_PROVIDER_NAVER:
+		return "naver", nil
+	default:
+		return "", ErrUnsupportedProvider
+	}
+}
+
+func providerFromName(name string) userpb.SocialProvider {
+	switch name {
+	case "kakao":
+		return userpb.SocialProvider_SOCIAL_PROVIDER_KAKAO
+	case "naver":
+		return userpb.SocialProvider_SOCIAL_PROVIDER_NAVER
+	default:
+		return userpb.SocialProvider_SOCIAL_PROVIDER_UNSPECIFIED
+	}
+}
+
+// fetchJSON: Bearer 토큰으로 GET 요청을 보내고 JSON 응답을 out에 읽음
+func fetchJSON(ctx context.Context, client *http.Client, url, accessToken string, out any) error {
+	if strings.TrimSpace(accessToken) == "" {
+		return ErrInvalidSocialToken
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("social provider request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return ErrInvalidSocialToken
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("social provider returned %s", resp.Status)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(out)
+}
+
+// ----- 카카오 -----
+
+type kakaoProvider struct {
+	baseURL string
+	appID   string // 이 서비스의 카카오 앱 ID
+	client  *http.Client
+}
+
+func (p *kakaoProvider) FetchProfile(ctx context.Context, accessToken string) (*SocialProfile, error) {
+	// 다른 앱이 받은 토큰으로 로그인하지 못하도록, 토큰을 발급받은 앱부터 확인
+	var info struct {
+		ID    int64 `json:"id"`
+		AppID int64 `json:"app_id"`
+	}
+	if err := fetchJSON(ctx, p.client, p.baseURL+"/v1/user/access_token_info", accessToken, &info); err != nil {
+		return nil, err
+	}
+	if strconv.FormatInt(info.AppID, 10) != p.appID {
+		return nil, ErrInvalidSocialToken
+	}
+
+	var body struct {
+		ID           int64 `json:"id"`
+		KakaoAccount struct {
+			Email           string `json:"email"`
+			IsEmailValid    bool   `json:"is_email_valid"`
+			IsEmailVerified bool   `json:"is_email_verified"`
+			Profile         struct {
+				Nickname        string `json:"nickname"`
+				ProfileImageURL string `json:"profile_image_url"`
+			} `json:"profile"`
+		} `json:"kakao_account"`
+	}
+	if err := fetchJSON(ctx, p.client, p.baseURL+"/v2/user/me", accessToken, &body); err != nil {
+		return nil, err
+	}
+	if body.ID == 0 || body.ID != info.ID {
+		return nil, ErrInvalidSocialToken
+	}
+
+	account := body.KakaoAccount
+	return &SocialProfile{
+		Provider:       userpb.SocialProvider_SOCIAL_PROVIDER_KAKAO,
+		ProviderUserID: strconv.FormatInt(body.ID, 10),
+		Email:          account.Email,
+		EmailVerified:  account.Email != "" && account.IsEmailValid && account.IsEmailVerified,
+		Nickname:       account.Profile.Nickname,
+		AvatarURL:      account.Profile.ProfileImageURL,
+	}, nil
+}
+
+// ----- 네이버 -----
+
+type naverProvider struct {
+	baseURL  string
+	clientID string // 이 서비스의 네이버 Client ID
+	client   *http.Client
+}
+
+func (p *naverProvider) FetchProfile(ctx context.Context, accessToken string) (*SocialProfile, error) {
+	// 다른 앱이 받은 토큰으로 로그인하지 못하도록, 토큰 검증 API로 발급받은 Client ID부터 확인
+	var verify struct {
+		ResultCode string `json:"resultcode"`
+		Response   struct {
+			ClientID string `json:"client_id"`
+		} `json:"response"`
+	}
+	if err := fetchJSON(ctx, p.client, p.baseURL+"/v1/nid/verify?info=true", accessToken, &verify); err != nil {
+		return nil, err
+	}
+	if verify.ResultCode != "00" || verify.Response.ClientID != p.clientID {
+		return nil, ErrInvalidSocialToken
+	}
+
+	var body struct {
+		ResultCode string `json:"resultcode"`
+		Message    string `json:"message"`
+		Response   struct {
+			ID           string `json:"id"`
+			Email        string `json:"email"`
+			Nickname     string `json:"nickname"`
+			ProfileImage string `json:"profile_image"`
+		} `json:"response"`
+	}
+	if err := fetchJSON(ctx, p.client, p.baseURL+"/v1/nid/me", accessToken, &body); err != nil {
+		return nil, err
+	}
+	// 네이버는 토큰 오류도 200 + resultcode로 알려줄 때가 있음
+	if body.ResultCode != "00" || body.Response.ID == "" {
+		return nil, ErrInvalidSocialToken
+	}
+
+	r := body.Response
+	return &SocialProfile{
+		Provider:       userpb.SocialProvider_SOCIAL_PROVIDER_NAVER,
+		ProviderUserID: r.ID,
+		Email:          r.Email,
+		EmailVerified:  r.Email != "", // 네이버는 인증된 이메일만 내려줌
+		Nickname:       r.Nickname,
+		AvatarURL:      r.ProfileImage,
+	}, nil
+}
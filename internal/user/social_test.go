@@ -0,0 +1,151 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Dorazi23/gRPC_Chat_Project/internal/config"
+	"github.com/Dorazi23/gRPC_Chat_Project/pkg/userpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newFakeSocialAPI: 카카오/네이버 토큰 정보/사용자 정보 API를 흉내 내는 로컬 서버
+// "good" 토큰만 통과, 카카오 "unverified"는 인증 안 된 이메일, 네이버 "expired"는 200 + 오류 resultcode
+// "other-app"은 유효하지만 다른 앱(앱 ID 9999 / Client ID other-client)에 발급된 토큰
+func newFakeSocialAPI(t *testing.T) config.SocialConfig {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/user/access_token_info", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "Bearer good":
+			w.Write([]byte(`{"id":1234567890,"expires_in":7199,"app_id":1001}`))
+		case "Bearer unverified":
+			w.Write([]byte(`{"id":42,"expires_in":7199,"app_id":1001}`))
+		case "Bearer other-app":
+			w.Write([]byte(`{"id":1234567890,"expires_in":7199,"app_id":9999}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"msg":"this access token does not exist","code":-401}`))
+		}
+	})
+	mux.HandleFunc("/v2/user/me", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "Bearer good", "Bearer other-app":
+			w.Write([]byte(`{"id":1234567890,"kakao_account":{"email":"alice@kakao.test","is_email_valid":true,"is_email_verified":true,
+				"profile":{"nickname":"앨리스","profile_image_url":"https://img.test/a.png"}}}`))
+		case "Bearer unverified":
+			w.Write([]byte(`{"id":42,"kakao_account":{"email":"bob@kakao.test","is_email_valid":true,"is_email_verified":false}}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"msg":"this access token does not exist","code":-401}`))
+		}
+	})
+	mux.HandleFunc("/v1/nid/verify", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "Bearer good", "Bearer expired":
+			w.Write([]byte(`{"resultcode":"00","message":"success","response":{"token":"x","expire_date":"2030-01-01 00:00:00","client_id":"naver-client"}}`))
+		case "Bearer other-app":
+			w.Write([]byte(`{"resultcode":"00","message":"success","response":{"token":"x","expire_date":"2030-01-01 00:00:00","client_id":"other-client"}}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	})
+	mux.HandleFunc("/v1/nid/me", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "Bearer good", "Bearer other-app":
+			w.Write([]byte(`{"resultcode":"00","message":"success","response":{"id":"nv-abc","email":"carol@naver.test","nickname":"캐롤"}}`))
+		case "Bearer expired":
+			w.Write([]byte(`{"resultcode":"024","message":"Authentication failed"}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return config.SocialConfig{KakaoAPIURL: srv.URL, KakaoAppID: "1001", NaverAPIURL: srv.URL + "/", NaverClientID: "naver-client", Timeout: time.Second}
+}
+
+func TestIdentityProviders(t *testing.T) {
+	ctx := context.Background()
+	providers := NewIdentityProviders(newFakeSocialAPI(t))
+	kakao := providers[userpb.SocialProvider_SOCIAL_PROVIDER_KAKAO]
+	naver := providers[userpb.SocialProvider_SOCIAL_PROVIDER_NAVER]
+
+	p, err := kakao.FetchProfile(ctx, "good")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.ProviderUserID != "1234567890" || p.Email != "alice@kakao.test" || !p.EmailVerified || p.Nickname != "앨리스" || p.AvatarURL == "" {
+		t.Errorf("kakao profile = %+v", p)
+	}
+
+	p, err = kakao.FetchProfile(ctx, "unverified")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.EmailVerified {
+		t.Error("unverified kakao email reported as verified")
+	}
+
+	p, err = naver.FetchProfile(ctx, "good")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.ProviderUserID != "nv-abc" || p.Email != "carol@naver.test" || !p.EmailVerified || p.Provider != userpb.SocialProvider_SOCIAL_PROVIDER_NAVER {
+		t.Errorf("naver profile = %+v", p)
+	}
+
+	for name, fetch := range map[string]func() error{
+		"kakao 401":           func() error { _, err := kakao.FetchProfile(ctx, "bad"); return err },
+		"kakao empty token":   func() error { _, err := kakao.FetchProfile(ctx, ""); return err },
+		"naver 401":           func() error { _, err := naver.FetchProfile(ctx, "bad"); return err },
+		"naver error in body": func() error { _, err := naver.FetchProfile(ctx, "expired"); return err },
+		"kakao other app":     func() error { _, err := kakao.FetchProfile(ctx, "other-app"); return err },
+		"naver other app":     func() error { _, err := naver.FetchProfile(ctx, "other-app"); return err },
+	} {
+		if err := fetch(); err != ErrInvalidSocialToken {
+			t.Errorf("%s: err = %v, want ErrInvalidSocialToken", name, err)
+		}
+	}
+}
+
+// 다른 앱에 발급된 토큰은 DB를 보기 전에 Unauthenticated로 거부
+func TestSocialLoginRejectsTokenForAnotherApp(t *testing.T) {
+	h := NewHandler(NewService(nil, nil, NewIdentityProviders(newFakeSocialAPI(t))))
+	for _, provider := range []userpb.SocialProvider{userpb.SocialProvider_SOCIAL_PROVIDER_KAKAO, userpb.SocialProvider_SOCIAL_PROVIDER_NAVER} {
+		_, err := h.SocialLogin(context.Background(), &userpb.SocialLoginRequest{Provider: provider, AccessToken: "other-app"})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("%v: err = %v, want Unauthenticated", provider, err)
+		}
+	}
+}
+
+// 앱 ID를 설정하지 않은 제공자는 쓰지 않음
+func TestIdentityProvidersNeedAppIDs(t *testing.T) {
+	cfg := newFakeSocialAPI(t)
+	cfg.NaverClientID = ""
+	providers := NewIdentityProviders(cfg)
+	if _, ok := providers[userpb.SocialProvider_SOCIAL_PROVIDER_NAVER]; ok {
+		t.Error("naver provider enabled without social.naver_client_id")
+	}
+	if _, ok := providers[userpb.SocialProvider_SOCIAL_PROVIDER_KAKAO]; !ok {
+		t.Error("kakao provider missing with social.kakao_app_id set")
+	}
+}
+
+func TestProviderNames(t *testing.T) {
+	for _, p := range []userpb.SocialProvider{userpb.SocialProvider_SOCIAL_PROVIDER_KAKAO, userpb.SocialProvider_SOCIAL_PROVIDER_NAVER} {
+		name, err := providerName(p)
+		if err != nil || providerFromName(name) != p {
+			t.Errorf("%v: name %q err %v", p, name, err)
+		}
+	}
+	if _, err := providerName(userpb.SocialProvider_SOCIAL_PROVIDER_UNSPECIFIED); err != ErrUnsupportedProvider {
+		t.Errorf("unspecified provider: err = %v", err)
+	}
+}
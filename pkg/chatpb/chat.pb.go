@@ -9,6 +9,7 @@ package chatpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,31 +22,1657 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PageDirection int32
+
+const (
+	PageDirection_PAGE_DIRECTION_UNSPECIFIED PageDirection = 0 // BACKWARD와 같음
+	PageDirection_PAGE_DIRECTION_BACKWARD    PageDirection = 1 // cursor보다 오래된 메시지 (cursor가 없으면 최신 페이지)
+	PageDirection_PAGE_DIRECTION_FORWARD     PageDirection = 2 // cursor보다 최신 메시지 (cursor가 없으면 가장 오래된 페이지)
+)
+
+// Enum value maps for PageDirection.
+var (
+	PageDirection_name = map[int32]string{
+		0: "PAGE_DIRECTION_UNSPECIFIED",
+		1: "PAGE_DIRECTION_BACKWARD",
+		2: "PAGE_DIRECTION_FORWARD",
+	}
+	PageDirection_value = map[string]int32{
+		"PAGE_DIRECTION_UNSPECIFIED": 0,
+		"PAGE_DIRECTION_BACKWARD":    1,
+		"PAGE_DIRECTION_FORWARD":     2,
+	}
+)
+
+func (x PageDirection) Enum() *PageDirection {
+	p := new(PageDirection)
+	*p = x
+	return p
+}
+
+func (x PageDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PageDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_chat_proto_enumTypes[0].Descriptor()
+}
+
+func (PageDirection) Type() protoreflect.EnumType {
+	return &file_proto_chat_proto_enumTypes[0]
+}
+
+func (x PageDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PageDirection.Descriptor instead.
+func (PageDirection) EnumDescriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{0}
+}
+
 // 채팅 메시지 정의
+// 4번 이후 필드는 서버가 채워서 내려줌 (클라이언트가 보낸 값은 무시)
 type ChatMessage struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Roomid    string                 `protobuf:"bytes,1,opt,name=roomid,proto3" json:"roomid,omitempty"`                        // 채팅방 ID
+	Username  string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`                    // 사용자 이름
+	Message   string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                      // 메시지 내용
+	Version   int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`                     // 메시지 포맷 버전 (현재 1)
+	MessageId string                 `protobuf:"bytes,5,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 서버가 발급한 메시지 ID (UUID)
+	SentAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`          // 서버 저장 시각
+	SenderId  string                 `protobuf:"bytes,7,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`    // 보낸 사람의 유저 ID (토큰 기준)
+	Cursor    string                 `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`                        // 이 메시지 위치의 cursor (GetMessages / 재접속 시 사용)
+	// 클라이언트 → 서버: JoinChat 첫 메시지에서만 사용 (재접속)
+	// 둘 중 하나를 보내면 그 이후 메시지만 다시 보내줌 (둘 다 비어 있으면 최근 메시지부터)
+	ResumeCursor  string `protobuf:"bytes,8,opt,name=resume_cursor,json=resumeCursor,proto3" json:"resume_cursor,omitempty"`
+	LastMessageId string `protobuf:"bytes,10,opt,name=last_message_id,json=lastMessageId,proto3" json:"last_message_id,omitempty"` // 마지막으로 받은 message_id
+	// 클라이언트가 만든 중복 방지 키 (재전송해도 한 번만 저장됨, 서버 응답에도 그대로 실려 옴)
+	ClientMessageId string `protobuf:"bytes,11,opt,name=client_message_id,json=clientMessageId,proto3" json:"client_message_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_proto_chat_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChatMessage) GetRoomid() string {
+	if x != nil {
+		return x.Roomid
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetResumeCursor() string {
+	if x != nil {
+		return x.ResumeCursor
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetLastMessageId() string {
+	if x != nil {
+		return x.LastMessageId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetClientMessageId() string {
+	if x != nil {
+		return x.ClientMessageId
+	}
+	return ""
+}
+
+// 타이핑 시작/종료
+// 클라이언트는 is_typing만 보내면 되고, user_id/username은 서버가 채움
+type TypingEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsTyping      bool                   `protobuf:"varint,1,opt,name=is_typing,json=isTyping,proto3" json:"is_typing,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypingEvent) Reset() {
+	*x = TypingEvent{}
+	mi := &file_proto_chat_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypingEvent) ProtoMessage() {}
+
+func (x *TypingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypingEvent.ProtoReflect.Descriptor instead.
+func (*TypingEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TypingEvent) GetIsTyping() bool {
+	if x != nil {
+		return x.IsTyping
+	}
+	return false
+}
+
+func (x *TypingEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TypingEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// 멤버 입장/퇴장 (접속 기준, 같은 유저의 첫 연결/마지막 연결일 때만 발생)
+type MemberEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberEvent) Reset() {
+	*x = MemberEvent{}
+	mi := &file_proto_chat_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberEvent) ProtoMessage() {}
+
+func (x *MemberEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberEvent.ProtoReflect.Descriptor instead.
+func (*MemberEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MemberEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MemberEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// 현재 방에 접속 중인 멤버 목록 (입장 직후 본인에게만 보냄)
+type PresenceEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OnlineUsernames []string               `protobuf:"bytes,1,rep,name=online_usernames,json=onlineUsernames,proto3" json:"online_usernames,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PresenceEvent) Reset() {
+	*x = PresenceEvent{}
+	mi := &file_proto_chat_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresenceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceEvent) ProtoMessage() {}
+
+func (x *PresenceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceEvent.ProtoReflect.Descriptor instead.
+func (*PresenceEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PresenceEvent) GetOnlineUsernames() []string {
+	if x != nil {
+		return x.OnlineUsernames
+	}
+	return nil
+}
+
+// 스트림을 끊지 않는 에러 (잘못된 이벤트 등)
+type ErrorEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Code            int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"` // google.rpc.Code 값 (grpc codes)
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ClientMessageId string                 `protobuf:"bytes,3,opt,name=client_message_id,json=clientMessageId,proto3" json:"client_message_id,omitempty"` // 특정 메시지 때문에 난 에러면 그 메시지의 client_message_id
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ErrorEvent) Reset() {
+	*x = ErrorEvent{}
+	mi := &file_proto_chat_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorEvent) ProtoMessage() {}
+
+func (x *ErrorEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorEvent.ProtoReflect.Descriptor instead.
+func (*ErrorEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ErrorEvent) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ErrorEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ErrorEvent) GetClientMessageId() string {
+	if x != nil {
+		return x.ClientMessageId
+	}
+	return ""
+}
+
+// 읽음 표시 (MarkRoomRead로 읽은 위치가 앞으로 움직였을 때 방 전체에 보냄)
+type ReadReceiptEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 여기까지 읽음
+	ReadAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadReceiptEvent) Reset() {
+	*x = ReadReceiptEvent{}
+	mi := &file_proto_chat_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadReceiptEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadReceiptEvent) ProtoMessage() {}
+
+func (x *ReadReceiptEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadReceiptEvent.ProtoReflect.Descriptor instead.
+func (*ReadReceiptEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReadReceiptEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReadReceiptEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReadReceiptEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ReadReceiptEvent) GetReadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+// 보낸 메시지가 저장(commit)됨 (보낸 사람에게만)
+// 방 브로드캐스트는 ack 이후에만 일어남
+type MessageAckEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ClientMessageId string                 `protobuf:"bytes,1,opt,name=client_message_id,json=clientMessageId,proto3" json:"client_message_id,omitempty"`
+	Message         *ChatMessage           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`      // 저장된 메시지 (message_id / sent_at / cursor 포함)
+	Duplicate       bool                   `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"` // 이미 저장된 메시지의 재전송이었음 (다시 브로드캐스트하지 않음)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MessageAckEvent) Reset() {
+	*x = MessageAckEvent{}
+	mi := &file_proto_chat_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageAckEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageAckEvent) ProtoMessage() {}
+
+func (x *MessageAckEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageAckEvent.ProtoReflect.Descriptor instead.
+func (*MessageAckEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MessageAckEvent) GetClientMessageId() string {
+	if x != nil {
+		return x.ClientMessageId
+	}
+	return ""
+}
+
+func (x *MessageAckEvent) GetMessage() *ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *MessageAckEvent) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+// 보낸 메시지가 저장되지 않음 (보낸 사람에게만, 브로드캐스트도 하지 않음)
+type MessageNackEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ClientMessageId string                 `protobuf:"bytes,1,opt,name=client_message_id,json=clientMessageId,proto3" json:"client_message_id,omitempty"`
+	Code            int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"` // google.rpc.Code 값 (grpc codes)
+	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Retryable       bool                   `protobuf:"varint,4,opt,name=retryable,proto3" json:"retryable,omitempty"` // 같은 client_message_id로 다시 보내도 되는지
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MessageNackEvent) Reset() {
+	*x = MessageNackEvent{}
+	mi := &file_proto_chat_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageNackEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageNackEvent) ProtoMessage() {}
+
+func (x *MessageNackEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageNackEvent.ProtoReflect.Descriptor instead.
+func (*MessageNackEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MessageNackEvent) GetClientMessageId() string {
+	if x != nil {
+		return x.ClientMessageId
+	}
+	return ""
+}
+
+func (x *MessageNackEvent) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *MessageNackEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MessageNackEvent) GetRetryable() bool {
+	if x != nil {
+		return x.Retryable
+	}
+	return false
+}
+
+// 서버가 종료(배포 등)되기 직전에 접속자 전원에게 보냄
+// 이 이벤트 뒤에 스트림이 UNAVAILABLE로 끝나므로, 클라이언트는 resume_cursor로 다른 인스턴스에 다시 접속하면 됨
+type ServerGoingAwayEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerGoingAwayEvent) Reset() {
+	*x = ServerGoingAwayEvent{}
+	mi := &file_proto_chat_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerGoingAwayEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerGoingAwayEvent) ProtoMessage() {}
+
+func (x *ServerGoingAwayEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerGoingAwayEvent.ProtoReflect.Descriptor instead.
+func (*ServerGoingAwayEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ServerGoingAwayEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 클라이언트 → 서버 이벤트
+// 첫 이벤트는 반드시 message (방 입장 핸드셰이크: roomid / resume_cursor / last_message_id)
+type ChatClientEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*ChatClientEvent_Message
+	//	*ChatClientEvent_Typing
+	Event         isChatClientEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatClientEvent) Reset() {
+	*x = ChatClientEvent{}
+	mi := &file_proto_chat_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatClientEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatClientEvent) ProtoMessage() {}
+
+func (x *ChatClientEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatClientEvent.ProtoReflect.Descriptor instead.
+func (*ChatClientEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChatClientEvent) GetEvent() isChatClientEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ChatClientEvent) GetMessage() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Event.(*ChatClientEvent_Message); ok {
+			return x.Message
+		}
+	}
+	return nil
+}
+
+func (x *ChatClientEvent) GetTyping() *TypingEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatClientEvent_Typing); ok {
+			return x.Typing
+		}
+	}
+	return nil
+}
+
+type isChatClientEvent_Event interface {
+	isChatClientEvent_Event()
+}
+
+type ChatClientEvent_Message struct {
+	Message *ChatMessage `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
+}
+
+type ChatClientEvent_Typing struct {
+	Typing *TypingEvent `protobuf:"bytes,2,opt,name=typing,proto3,oneof"`
+}
+
+func (*ChatClientEvent_Message) isChatClientEvent_Event() {}
+
+func (*ChatClientEvent_Typing) isChatClientEvent_Event() {}
+
+// 서버 → 클라이언트 이벤트
+// message만 DB에 저장되는 채팅이고, 나머지는 저장되지 않는 일회성 이벤트
+type ChatEvent struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*ChatEvent_Message
+	//	*ChatEvent_Typing
+	//	*ChatEvent_MemberJoined
+	//	*ChatEvent_MemberLeft
+	//	*ChatEvent_Presence
+	//	*ChatEvent_Error
+	//	*ChatEvent_ReadReceipt
+	//	*ChatEvent_Ack
+	//	*ChatEvent_Nack
+	//	*ChatEvent_GoingAway
+	//	*ChatEvent_MemberRemoved
+	Event         isChatEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatEvent) Reset() {
+	*x = ChatEvent{}
+	mi := &file_proto_chat_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatEvent) ProtoMessage() {}
+
+func (x *ChatEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatEvent.ProtoReflect.Descriptor instead.
+func (*ChatEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ChatEvent) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ChatEvent) GetEvent() isChatEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetMessage() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Event.(*ChatEvent_Message); ok {
+			return x.Message
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetTyping() *TypingEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatEvent_Typing); ok {
+			return x.Typing
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetMemberJoined() *MemberEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatEvent_MemberJoined); ok {
+			return x.MemberJoined
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetMemberLeft() *MemberEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatEvent_MemberLeft); ok {
+			return x.MemberLeft
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetPresence() *PresenceEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatEvent_Presence); ok {
+			return x.Presence
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetError() *ErrorEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatEvent_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetReadReceipt() *ReadReceiptEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatEvent_ReadReceipt); ok {
+			return x.ReadReceipt
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetAck() *MessageAckEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatEvent_Ack); ok {
+			return x.Ack
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetNack() *MessageNackEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatEvent_Nack); ok {
+			return x.Nack
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetGoingAway() *ServerGoingAwayEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatEvent_GoingAway); ok {
+			return x.GoingAway
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetMemberRemoved() *MemberEvent {
+	if x != nil {
+		if x, ok := x.Event.(*ChatEvent_MemberRemoved); ok {
+			return x.MemberRemoved
+		}
+	}
+	return nil
+}
+
+type isChatEvent_Event interface {
+	isChatEvent_Event()
+}
+
+type ChatEvent_Message struct {
+	Message *ChatMessage `protobuf:"bytes,2,opt,name=message,proto3,oneof"`
+}
+
+type ChatEvent_Typing struct {
+	Typing *TypingEvent `protobuf:"bytes,3,opt,name=typing,proto3,oneof"`
+}
+
+type ChatEvent_MemberJoined struct {
+	MemberJoined *MemberEvent `protobuf:"bytes,4,opt,name=member_joined,json=memberJoined,proto3,oneof"`
+}
+
+type ChatEvent_MemberLeft struct {
+	MemberLeft *MemberEvent `protobuf:"bytes,5,opt,name=member_left,json=memberLeft,proto3,oneof"`
+}
+
+type ChatEvent_Presence struct {
+	Presence *PresenceEvent `protobuf:"bytes,6,opt,name=presence,proto3,oneof"`
+}
+
+type ChatEvent_Error struct {
+	Error *ErrorEvent `protobuf:"bytes,7,opt,name=error,proto3,oneof"`
+}
+
+type ChatEvent_ReadReceipt struct {
+	ReadReceipt *ReadReceiptEvent `protobuf:"bytes,8,opt,name=read_receipt,json=readReceipt,proto3,oneof"`
+}
+
+type ChatEvent_Ack struct {
+	Ack *MessageAckEvent `protobuf:"bytes,9,opt,name=ack,proto3,oneof"`
+}
+
+type ChatEvent_Nack struct {
+	Nack *MessageNackEvent `protobuf:"bytes,10,opt,name=nack,proto3,oneof"`
+}
+
+type ChatEvent_GoingAway struct {
+	GoingAway *ServerGoingAwayEvent `protobuf:"bytes,11,opt,name=going_away,json=goingAway,proto3,oneof"`
+}
+
+type ChatEvent_MemberRemoved struct {
+	MemberRemoved *MemberEvent `protobuf:"bytes,12,opt,name=member_removed,json=memberRemoved,proto3,oneof"` // 그룹 방에서 내보내짐 (내보내진 유저의 스트림은 PermissionDenied로 끝남)
+}
+
+func (*ChatEvent_Message) isChatEvent_Event() {}
+
+func (*ChatEvent_Typing) isChatEvent_Event() {}
+
+func (*ChatEvent_MemberJoined) isChatEvent_Event() {}
+
+func (*ChatEvent_MemberLeft) isChatEvent_Event() {}
+
+func (*ChatEvent_Presence) isChatEvent_Event() {}
+
+func (*ChatEvent_Error) isChatEvent_Event() {}
+
+func (*ChatEvent_ReadReceipt) isChatEvent_Event() {}
+
+func (*ChatEvent_Ack) isChatEvent_Event() {}
+
+func (*ChatEvent_Nack) isChatEvent_Event() {}
+
+func (*ChatEvent_GoingAway) isChatEvent_Event() {}
+
+func (*ChatEvent_MemberRemoved) isChatEvent_Event() {}
+
+// 방 ID 요청 메시지
+type GetRoomIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MyId          string                 `protobuf:"bytes,1,opt,name=my_id,json=myId,proto3" json:"my_id,omitempty"`          // 내 아이디
+	OtherId       string                 `protobuf:"bytes,2,opt,name=other_id,json=otherId,proto3" json:"other_id,omitempty"` // 대화할 상대방 아이디
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomIDRequest) Reset() {
+	*x = GetRoomIDRequest{}
+	mi := &file_proto_chat_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomIDRequest) ProtoMessage() {}
+
+func (x *GetRoomIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomIDRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetRoomIDRequest) GetMyId() string {
+	if x != nil {
+		return x.MyId
+	}
+	return ""
+}
+
+func (x *GetRoomIDRequest) GetOtherId() string {
+	if x != nil {
+		return x.OtherId
+	}
+	return ""
+}
+
+// 방 ID 응답 메시지
+type GetRoomIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"` // 서버가 발급한 방 ID (UUID, 같은 두 사람이면 항상 같은 값)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomIDResponse) Reset() {
+	*x = GetRoomIDResponse{}
+	mi := &file_proto_chat_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomIDResponse) ProtoMessage() {}
+
+func (x *GetRoomIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomIDResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetRoomIDResponse) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+// [추가] 내 채팅방 목록 요청
+type GetMyRoomsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // 내 아이디 (로그인한 사용자)
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                         // 한 번에 몇 개까지 (기본 20, 최대 100)
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 이전 응답의 next_page_token (첫 페이지는 비움)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyRoomsRequest) Reset() {
+	*x = GetMyRoomsRequest{}
+	mi := &file_proto_chat_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyRoomsRequest) ProtoMessage() {}
+
+func (x *GetMyRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyRoomsRequest.ProtoReflect.Descriptor instead.
+func (*GetMyRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMyRoomsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetMyRoomsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetMyRoomsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// [추가] 채팅방 정보 구조체
+type ChatRoomInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RoomId            string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	OtherUserId       string                 `protobuf:"bytes,2,opt,name=other_user_id,json=otherUserId,proto3" json:"other_user_id,omitempty"`                     // 상대방 아이디 (1:1 방일 때만, 프론트에 보여줄 용도)
+	IsGroup           bool                   `protobuf:"varint,3,opt,name=is_group,json=isGroup,proto3" json:"is_group,omitempty"`                                  // 그룹 채팅방 여부
+	Title             string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                                                      // 그룹 채팅방 이름 (1:1 방은 비어 있음)
+	MemberCount       int32                  `protobuf:"varint,5,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`                      // 방 인원 수
+	UnreadCount       int32                  `protobuf:"varint,6,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`                      // 내가 안 읽은 메시지 수 (내가 보낸 메시지 제외)
+	LastReadMessageId string                 `protobuf:"bytes,7,opt,name=last_read_message_id,json=lastReadMessageId,proto3" json:"last_read_message_id,omitempty"` // 내가 마지막으로 읽은 메시지 ID (없으면 비어 있음)
+	// 1:1 방 상대방 프로필
+	OtherUserNickname  string `protobuf:"bytes,8,opt,name=other_user_nickname,json=otherUserNickname,proto3" json:"other_user_nickname,omitempty"`
+	OtherUserAvatarUrl string `protobuf:"bytes,9,opt,name=other_user_avatar_url,json=otherUserAvatarUrl,proto3" json:"other_user_avatar_url,omitempty"`
+	// 마지막 메시지 미리보기 (메시지가 없는 방은 비어 있음)
+	LastMessageId       string                 `protobuf:"bytes,10,opt,name=last_message_id,json=lastMessageId,proto3" json:"last_message_id,omitempty"`
+	LastMessagePreview  string                 `protobuf:"bytes,11,opt,name=last_message_preview,json=lastMessagePreview,proto3" json:"last_message_preview,omitempty"` // 앞부분 최대 100글자
+	LastMessageSenderId string                 `protobuf:"bytes,12,opt,name=last_message_sender_id,json=lastMessageSenderId,proto3" json:"last_message_sender_id,omitempty"`
+	LastMessageUsername string                 `protobuf:"bytes,13,opt,name=last_message_username,json=lastMessageUsername,proto3" json:"last_message_username,omitempty"`
+	LastMessageAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=last_message_at,json=lastMessageAt,proto3" json:"last_message_at,omitempty"`
+	LastActivityAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"` // 정렬 기준 (마지막 메시지 시각, 없으면 방 생성 시각)
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ChatRoomInfo) Reset() {
+	*x = ChatRoomInfo{}
+	mi := &file_proto_chat_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatRoomInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatRoomInfo) ProtoMessage() {}
+
+func (x *ChatRoomInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatRoomInfo.ProtoReflect.Descriptor instead.
+func (*ChatRoomInfo) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ChatRoomInfo) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ChatRoomInfo) GetOtherUserId() string {
+	if x != nil {
+		return x.OtherUserId
+	}
+	return ""
+}
+
+func (x *ChatRoomInfo) GetIsGroup() bool {
+	if x != nil {
+		return x.IsGroup
+	}
+	return false
+}
+
+func (x *ChatRoomInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChatRoomInfo) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *ChatRoomInfo) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *ChatRoomInfo) GetLastReadMessageId() string {
+	if x != nil {
+		return x.LastReadMessageId
+	}
+	return ""
+}
+
+func (x *ChatRoomInfo) GetOtherUserNickname() string {
+	if x != nil {
+		return x.OtherUserNickname
+	}
+	return ""
+}
+
+func (x *ChatRoomInfo) GetOtherUserAvatarUrl() string {
+	if x != nil {
+		return x.OtherUserAvatarUrl
+	}
+	return ""
+}
+
+func (x *ChatRoomInfo) GetLastMessageId() string {
+	if x != nil {
+		return x.LastMessageId
+	}
+	return ""
+}
+
+func (x *ChatRoomInfo) GetLastMessagePreview() string {
+	if x != nil {
+		return x.LastMessagePreview
+	}
+	return ""
+}
+
+func (x *ChatRoomInfo) GetLastMessageSenderId() string {
+	if x != nil {
+		return x.LastMessageSenderId
+	}
+	return ""
+}
+
+func (x *ChatRoomInfo) GetLastMessageUsername() string {
+	if x != nil {
+		return x.LastMessageUsername
+	}
+	return ""
+}
+
+func (x *ChatRoomInfo) GetLastMessageAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastMessageAt
+	}
+	return nil
+}
+
+func (x *ChatRoomInfo) GetLastActivityAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return nil
+}
+
+// [추가] 내 채팅방 목록 응답
+type GetMyRoomsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []*ChatRoomInfo        `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`                                        // 최근 활동순
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 다음 페이지가 없으면 비어 있음
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyRoomsResponse) Reset() {
+	*x = GetMyRoomsResponse{}
+	mi := &file_proto_chat_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyRoomsResponse) ProtoMessage() {}
+
+func (x *GetMyRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyRoomsResponse.ProtoReflect.Descriptor instead.
+func (*GetMyRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetMyRoomsResponse) GetRooms() []*ChatRoomInfo {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *GetMyRoomsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // ChatMessage.cursor 또는 이전 응답의 prev/next_cursor
+	Direction     PageDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=chat.v1.PageDirection" json:"direction,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"` // 기본 50, 최대 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessagesRequest) Reset() {
+	*x = GetMessagesRequest{}
+	mi := &file_proto_chat_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesRequest) ProtoMessage() {}
+
+func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetMessagesRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetMessagesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetMessagesRequest) GetDirection() PageDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return PageDirection_PAGE_DIRECTION_UNSPECIFIED
+}
+
+func (x *GetMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`                       // 항상 오래된 순
+	PrevCursor    string                 `protobuf:"bytes,2,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"` // 더 오래된 페이지를 볼 때 쓸 cursor (첫 메시지 위치)
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 더 최신 페이지를 볼 때 쓸 cursor (마지막 메시지 위치)
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`         // 요청한 방향으로 메시지가 더 있는지
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessagesResponse) Reset() {
+	*x = GetMessagesResponse{}
+	mi := &file_proto_chat_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesResponse) ProtoMessage() {}
+
+func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetMessagesResponse) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GetMessagesResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+func (x *GetMessagesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetMessagesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type MarkRoomReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 여기까지 읽음 (이미 더 뒤까지 읽었으면 무시)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkRoomReadRequest) Reset() {
+	*x = MarkRoomReadRequest{}
+	mi := &file_proto_chat_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkRoomReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkRoomReadRequest) ProtoMessage() {}
+
+func (x *MarkRoomReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkRoomReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkRoomReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MarkRoomReadRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *MarkRoomReadRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type MarkRoomReadResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UnreadCount       int32                  `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	LastReadMessageId string                 `protobuf:"bytes,2,opt,name=last_read_message_id,json=lastReadMessageId,proto3" json:"last_read_message_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MarkRoomReadResponse) Reset() {
+	*x = MarkRoomReadResponse{}
+	mi := &file_proto_chat_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkRoomReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkRoomReadResponse) ProtoMessage() {}
+
+func (x *MarkRoomReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkRoomReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkRoomReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MarkRoomReadResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *MarkRoomReadResponse) GetLastReadMessageId() string {
+	if x != nil {
+		return x.LastReadMessageId
+	}
+	return ""
+}
+
+// 그룹 채팅방 생성 요청 (만든 사람은 자동으로 owner 멤버가 됨)
+type CreateGroupRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	MemberIds     []string               `protobuf:"bytes,2,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"` // 같이 초대할 유저 아이디들
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRoomRequest) Reset() {
+	*x = CreateGroupRoomRequest{}
+	mi := &file_proto_chat_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRoomRequest) ProtoMessage() {}
+
+func (x *CreateGroupRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRoomRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateGroupRoomRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateGroupRoomRequest) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+type CreateGroupRoomResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Roomid        string                 `protobuf:"bytes,1,opt,name=roomid,proto3" json:"roomid,omitempty"`     // 채팅방 ID
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"` // 사용자 이름
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`   // 메시지 내용
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChatMessage) Reset() {
-	*x = ChatMessage{}
-	mi := &file_proto_chat_proto_msgTypes[0]
+func (x *CreateGroupRoomResponse) Reset() {
+	*x = CreateGroupRoomResponse{}
+	mi := &file_proto_chat_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChatMessage) String() string {
+func (x *CreateGroupRoomResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatMessage) ProtoMessage() {}
+func (*CreateGroupRoomResponse) ProtoMessage() {}
 
-func (x *ChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_chat_proto_msgTypes[0]
+func (x *CreateGroupRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,56 +1683,91 @@ func (x *ChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
-func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return file_proto_chat_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use CreateGroupRoomResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupRoomResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ChatMessage) GetRoomid() string {
+func (x *CreateGroupRoomResponse) GetRoomId() string {
 	if x != nil {
-		return x.Roomid
+		return x.RoomId
 	}
 	return ""
 }
 
-func (x *ChatMessage) GetUsername() string {
+type AddRoomMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MemberIds     []string               `protobuf:"bytes,2,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRoomMembersRequest) Reset() {
+	*x = AddRoomMembersRequest{}
+	mi := &file_proto_chat_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRoomMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRoomMembersRequest) ProtoMessage() {}
+
+func (x *AddRoomMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[22]
 	if x != nil {
-		return x.Username
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ChatMessage) GetMessage() string {
+// Deprecated: Use AddRoomMembersRequest.ProtoReflect.Descriptor instead.
+func (*AddRoomMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddRoomMembersRequest) GetRoomId() string {
 	if x != nil {
-		return x.Message
+		return x.RoomId
 	}
 	return ""
 }
 
-// 방 ID 요청 메시지
-type GetRoomIDRequest struct {
+func (x *AddRoomMembersRequest) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+type AddRoomMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MyId          string                 `protobuf:"bytes,1,opt,name=my_id,json=myId,proto3" json:"my_id,omitempty"`          // 내 아이디
-	OtherId       string                 `protobuf:"bytes,2,opt,name=other_id,json=otherId,proto3" json:"other_id,omitempty"` // 대화할 상대방 아이디
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoomIDRequest) Reset() {
-	*x = GetRoomIDRequest{}
-	mi := &file_proto_chat_proto_msgTypes[1]
+func (x *AddRoomMembersResponse) Reset() {
+	*x = AddRoomMembersResponse{}
+	mi := &file_proto_chat_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoomIDRequest) String() string {
+func (x *AddRoomMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoomIDRequest) ProtoMessage() {}
+func (*AddRoomMembersResponse) ProtoMessage() {}
 
-func (x *GetRoomIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_chat_proto_msgTypes[1]
+func (x *AddRoomMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -116,48 +1778,85 @@ func (x *GetRoomIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoomIDRequest.ProtoReflect.Descriptor instead.
-func (*GetRoomIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_chat_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use AddRoomMembersResponse.ProtoReflect.Descriptor instead.
+func (*AddRoomMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetRoomIDRequest) GetMyId() string {
+// 멤버 내보내기 (owner만 가능, 본인 아이디면 방 나가기)
+type RemoveRoomMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRoomMemberRequest) Reset() {
+	*x = RemoveRoomMemberRequest{}
+	mi := &file_proto_chat_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRoomMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRoomMemberRequest) ProtoMessage() {}
+
+func (x *RemoveRoomMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[24]
 	if x != nil {
-		return x.MyId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRoomMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRoomMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RemoveRoomMemberRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
 	}
 	return ""
 }
 
-func (x *GetRoomIDRequest) GetOtherId() string {
+func (x *RemoveRoomMemberRequest) GetMemberId() string {
 	if x != nil {
-		return x.OtherId
+		return x.MemberId
 	}
 	return ""
 }
 
-// 방 ID 응답 메시지
-type GetRoomIDResponse struct {
+type RemoveRoomMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"` // 계산된 방 ID (예: hun_min)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoomIDResponse) Reset() {
-	*x = GetRoomIDResponse{}
-	mi := &file_proto_chat_proto_msgTypes[2]
+func (x *RemoveRoomMemberResponse) Reset() {
+	*x = RemoveRoomMemberResponse{}
+	mi := &file_proto_chat_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoomIDResponse) String() string {
+func (x *RemoveRoomMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoomIDResponse) ProtoMessage() {}
+func (*RemoveRoomMemberResponse) ProtoMessage() {}
 
-func (x *GetRoomIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_chat_proto_msgTypes[2]
+func (x *RemoveRoomMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -168,41 +1867,84 @@ func (x *GetRoomIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoomIDResponse.ProtoReflect.Descriptor instead.
-func (*GetRoomIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_chat_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use RemoveRoomMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveRoomMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetRoomIDResponse) GetRoomId() string {
+type RenameRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameRoomRequest) Reset() {
+	*x = RenameRoomRequest{}
+	mi := &file_proto_chat_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameRoomRequest) ProtoMessage() {}
+
+func (x *RenameRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameRoomRequest.ProtoReflect.Descriptor instead.
+func (*RenameRoomRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RenameRoomRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-// [추가] 내 채팅방 목록 요청
-type GetMyRoomsRequest struct {
+func (x *RenameRoomRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type RenameRoomResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 내 아이디 (로그인한 사용자)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyRoomsRequest) Reset() {
-	*x = GetMyRoomsRequest{}
-	mi := &file_proto_chat_proto_msgTypes[3]
+func (x *RenameRoomResponse) Reset() {
+	*x = RenameRoomResponse{}
+	mi := &file_proto_chat_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyRoomsRequest) String() string {
+func (x *RenameRoomResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyRoomsRequest) ProtoMessage() {}
+func (*RenameRoomResponse) ProtoMessage() {}
 
-func (x *GetMyRoomsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_chat_proto_msgTypes[3]
+func (x *RenameRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -213,42 +1955,79 @@ func (x *GetMyRoomsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyRoomsRequest.ProtoReflect.Descriptor instead.
-func (*GetMyRoomsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_chat_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use RenameRoomResponse.ProtoReflect.Descriptor instead.
+func (*RenameRoomResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetMyRoomsRequest) GetUserId() string {
+type ListRoomMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomMembersRequest) Reset() {
+	*x = ListRoomMembersRequest{}
+	mi := &file_proto_chat_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomMembersRequest) ProtoMessage() {}
+
+func (x *ListRoomMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[28]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListRoomMembersRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
 	}
 	return ""
 }
 
-// [추가] 채팅방 정보 구조체
-type ChatRoomInfo struct {
+type RoomMember struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	OtherUserId   string                 `protobuf:"bytes,2,opt,name=other_user_id,json=otherUserId,proto3" json:"other_user_id,omitempty"` // 상대방 아이디 (프론트에 보여줄 용도)
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`                          // "owner" 또는 "member"
+	JoinedAt      int64                  `protobuf:"varint,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"` // 참여 시각 (unix timestamp)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChatRoomInfo) Reset() {
-	*x = ChatRoomInfo{}
-	mi := &file_proto_chat_proto_msgTypes[4]
+func (x *RoomMember) Reset() {
+	*x = RoomMember{}
+	mi := &file_proto_chat_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChatRoomInfo) String() string {
+func (x *RoomMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatRoomInfo) ProtoMessage() {}
+func (*RoomMember) ProtoMessage() {}
 
-func (x *ChatRoomInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_chat_proto_msgTypes[4]
+func (x *RoomMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -259,48 +2038,54 @@ func (x *ChatRoomInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatRoomInfo.ProtoReflect.Descriptor instead.
-func (*ChatRoomInfo) Descriptor() ([]byte, []int) {
-	return file_proto_chat_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use RoomMember.ProtoReflect.Descriptor instead.
+func (*RoomMember) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ChatRoomInfo) GetRoomId() string {
+func (x *RoomMember) GetUserId() string {
 	if x != nil {
-		return x.RoomId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ChatRoomInfo) GetOtherUserId() string {
+func (x *RoomMember) GetRole() string {
 	if x != nil {
-		return x.OtherUserId
+		return x.Role
 	}
 	return ""
 }
 
-// [추가] 내 채팅방 목록 응답
-type GetMyRoomsResponse struct {
+func (x *RoomMember) GetJoinedAt() int64 {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return 0
+}
+
+type ListRoomMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rooms         []*ChatRoomInfo        `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	Members       []*RoomMember          `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyRoomsResponse) Reset() {
-	*x = GetMyRoomsResponse{}
-	mi := &file_proto_chat_proto_msgTypes[5]
+func (x *ListRoomMembersResponse) Reset() {
+	*x = ListRoomMembersResponse{}
+	mi := &file_proto_chat_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyRoomsResponse) String() string {
+func (x *ListRoomMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyRoomsResponse) ProtoMessage() {}
+func (*ListRoomMembersResponse) ProtoMessage() {}
 
-func (x *GetMyRoomsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_chat_proto_msgTypes[5]
+func (x *ListRoomMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,14 +2096,14 @@ func (x *GetMyRoomsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyRoomsResponse.ProtoReflect.Descriptor instead.
-func (*GetMyRoomsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_chat_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListRoomMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetMyRoomsResponse) GetRooms() []*ChatRoomInfo {
+func (x *ListRoomMembersResponse) GetMembers() []*RoomMember {
 	if x != nil {
-		return x.Rooms
+		return x.Members
 	}
 	return nil
 }
@@ -327,28 +2112,167 @@ var File_proto_chat_proto protoreflect.FileDescriptor
 
 const file_proto_chat_proto_rawDesc = "" +
 	"\n" +
-	"\x10proto/chat.proto\x12\achat.v1\"[\n" +
+	"\x10proto/chat.proto\x12\achat.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf7\x02\n" +
 	"\vChatMessage\x12\x16\n" +
 	"\x06roomid\x18\x01 \x01(\tR\x06roomid\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"B\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x05 \x01(\tR\tmessageId\x123\n" +
+	"\asent_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\x12\x1b\n" +
+	"\tsender_id\x18\a \x01(\tR\bsenderId\x12\x16\n" +
+	"\x06cursor\x18\t \x01(\tR\x06cursor\x12#\n" +
+	"\rresume_cursor\x18\b \x01(\tR\fresumeCursor\x12&\n" +
+	"\x0flast_message_id\x18\n" +
+	" \x01(\tR\rlastMessageId\x12*\n" +
+	"\x11client_message_id\x18\v \x01(\tR\x0fclientMessageId\"_\n" +
+	"\vTypingEvent\x12\x1b\n" +
+	"\tis_typing\x18\x01 \x01(\bR\bisTyping\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\"B\n" +
+	"\vMemberEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\":\n" +
+	"\rPresenceEvent\x12)\n" +
+	"\x10online_usernames\x18\x01 \x03(\tR\x0fonlineUsernames\"f\n" +
+	"\n" +
+	"ErrorEvent\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x11client_message_id\x18\x03 \x01(\tR\x0fclientMessageId\"\x9b\x01\n" +
+	"\x10ReadReceiptEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\x123\n" +
+	"\aread_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06readAt\"\x8b\x01\n" +
+	"\x0fMessageAckEvent\x12*\n" +
+	"\x11client_message_id\x18\x01 \x01(\tR\x0fclientMessageId\x12.\n" +
+	"\amessage\x18\x02 \x01(\v2\x14.chat.v1.ChatMessageR\amessage\x12\x1c\n" +
+	"\tduplicate\x18\x03 \x01(\bR\tduplicate\"\x8a\x01\n" +
+	"\x10MessageNackEvent\x12*\n" +
+	"\x11client_message_id\x18\x01 \x01(\tR\x0fclientMessageId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1c\n" +
+	"\tretryable\x18\x04 \x01(\bR\tretryable\".\n" +
+	"\x14ServerGoingAwayEvent\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"|\n" +
+	"\x0fChatClientEvent\x120\n" +
+	"\amessage\x18\x01 \x01(\v2\x14.chat.v1.ChatMessageH\x00R\amessage\x12.\n" +
+	"\x06typing\x18\x02 \x01(\v2\x14.chat.v1.TypingEventH\x00R\x06typingB\a\n" +
+	"\x05event\"\x86\x05\n" +
+	"\tChatEvent\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x120\n" +
+	"\amessage\x18\x02 \x01(\v2\x14.chat.v1.ChatMessageH\x00R\amessage\x12.\n" +
+	"\x06typing\x18\x03 \x01(\v2\x14.chat.v1.TypingEventH\x00R\x06typing\x12;\n" +
+	"\rmember_joined\x18\x04 \x01(\v2\x14.chat.v1.MemberEventH\x00R\fmemberJoined\x127\n" +
+	"\vmember_left\x18\x05 \x01(\v2\x14.chat.v1.MemberEventH\x00R\n" +
+	"memberLeft\x124\n" +
+	"\bpresence\x18\x06 \x01(\v2\x16.chat.v1.PresenceEventH\x00R\bpresence\x12+\n" +
+	"\x05error\x18\a \x01(\v2\x13.chat.v1.ErrorEventH\x00R\x05error\x12>\n" +
+	"\fread_receipt\x18\b \x01(\v2\x19.chat.v1.ReadReceiptEventH\x00R\vreadReceipt\x12,\n" +
+	"\x03ack\x18\t \x01(\v2\x18.chat.v1.MessageAckEventH\x00R\x03ack\x12/\n" +
+	"\x04nack\x18\n" +
+	" \x01(\v2\x19.chat.v1.MessageNackEventH\x00R\x04nack\x12>\n" +
+	"\n" +
+	"going_away\x18\v \x01(\v2\x1d.chat.v1.ServerGoingAwayEventH\x00R\tgoingAway\x12=\n" +
+	"\x0emember_removed\x18\f \x01(\v2\x14.chat.v1.MemberEventH\x00R\rmemberRemovedB\a\n" +
+	"\x05event\"B\n" +
 	"\x10GetRoomIDRequest\x12\x13\n" +
 	"\x05my_id\x18\x01 \x01(\tR\x04myId\x12\x19\n" +
 	"\bother_id\x18\x02 \x01(\tR\aotherId\",\n" +
 	"\x11GetRoomIDResponse\x12\x17\n" +
-	"\aroom_id\x18\x01 \x01(\tR\x06roomId\",\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\"a\n" +
 	"\x11GetMyRoomsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"K\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xa3\x05\n" +
 	"\fChatRoomInfo\x12\x17\n" +
 	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\"\n" +
-	"\rother_user_id\x18\x02 \x01(\tR\votherUserId\"A\n" +
+	"\rother_user_id\x18\x02 \x01(\tR\votherUserId\x12\x19\n" +
+	"\bis_group\x18\x03 \x01(\bR\aisGroup\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12!\n" +
+	"\fmember_count\x18\x05 \x01(\x05R\vmemberCount\x12!\n" +
+	"\funread_count\x18\x06 \x01(\x05R\vunreadCount\x12/\n" +
+	"\x14last_read_message_id\x18\a \x01(\tR\x11lastReadMessageId\x12.\n" +
+	"\x13other_user_nickname\x18\b \x01(\tR\x11otherUserNickname\x121\n" +
+	"\x15other_user_avatar_url\x18\t \x01(\tR\x12otherUserAvatarUrl\x12&\n" +
+	"\x0flast_message_id\x18\n" +
+	" \x01(\tR\rlastMessageId\x120\n" +
+	"\x14last_message_preview\x18\v \x01(\tR\x12lastMessagePreview\x123\n" +
+	"\x16last_message_sender_id\x18\f \x01(\tR\x13lastMessageSenderId\x122\n" +
+	"\x15last_message_username\x18\r \x01(\tR\x13lastMessageUsername\x12B\n" +
+	"\x0flast_message_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\rlastMessageAt\x12D\n" +
+	"\x10last_activity_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x0elastActivityAt\"i\n" +
 	"\x12GetMyRoomsResponse\x12+\n" +
-	"\x05rooms\x18\x01 \x03(\v2\x15.chat.v1.ChatRoomInfoR\x05rooms2\xd4\x01\n" +
-	"\vChatService\x12:\n" +
-	"\bJoinChat\x12\x14.chat.v1.ChatMessage\x1a\x14.chat.v1.ChatMessage(\x010\x01\x12B\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x15.chat.v1.ChatRoomInfoR\x05rooms\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x91\x01\n" +
+	"\x12GetMessagesRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x124\n" +
+	"\tdirection\x18\x03 \x01(\x0e2\x16.chat.v1.PageDirectionR\tdirection\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xa4\x01\n" +
+	"\x13GetMessagesResponse\x120\n" +
+	"\bmessages\x18\x01 \x03(\v2\x14.chat.v1.ChatMessageR\bmessages\x12\x1f\n" +
+	"\vprev_cursor\x18\x02 \x01(\tR\n" +
+	"prevCursor\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"M\n" +
+	"\x13MarkRoomReadRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\"j\n" +
+	"\x14MarkRoomReadResponse\x12!\n" +
+	"\funread_count\x18\x01 \x01(\x05R\vunreadCount\x12/\n" +
+	"\x14last_read_message_id\x18\x02 \x01(\tR\x11lastReadMessageId\"M\n" +
+	"\x16CreateGroupRoomRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x02 \x03(\tR\tmemberIds\"2\n" +
+	"\x17CreateGroupRoomResponse\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\"O\n" +
+	"\x15AddRoomMembersRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x02 \x03(\tR\tmemberIds\"\x18\n" +
+	"\x16AddRoomMembersResponse\"O\n" +
+	"\x17RemoveRoomMemberRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\tmember_id\x18\x02 \x01(\tR\bmemberId\"\x1a\n" +
+	"\x18RemoveRoomMemberResponse\"B\n" +
+	"\x11RenameRoomRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"\x14\n" +
+	"\x12RenameRoomResponse\"1\n" +
+	"\x16ListRoomMembersRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\"V\n" +
+	"\n" +
+	"RoomMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1b\n" +
+	"\tjoined_at\x18\x03 \x01(\x03R\bjoinedAt\"H\n" +
+	"\x17ListRoomMembersResponse\x12-\n" +
+	"\amembers\x18\x01 \x03(\v2\x13.chat.v1.RoomMemberR\amembers*h\n" +
+	"\rPageDirection\x12\x1e\n" +
+	"\x1aPAGE_DIRECTION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PAGE_DIRECTION_BACKWARD\x10\x01\x12\x1a\n" +
+	"\x16PAGE_DIRECTION_FORWARD\x10\x022\x8c\x06\n" +
+	"\vChatService\x12<\n" +
+	"\bJoinChat\x12\x18.chat.v1.ChatClientEvent\x1a\x12.chat.v1.ChatEvent(\x010\x01\x12B\n" +
 	"\tGetRoomID\x12\x19.chat.v1.GetRoomIDRequest\x1a\x1a.chat.v1.GetRoomIDResponse\x12E\n" +
 	"\n" +
-	"GetMyRooms\x12\x1a.chat.v1.GetMyRoomsRequest\x1a\x1b.chat.v1.GetMyRoomsResponseB9Z7github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb;chatpbb\x06proto3"
+	"GetMyRooms\x12\x1a.chat.v1.GetMyRoomsRequest\x1a\x1b.chat.v1.GetMyRoomsResponse\x12H\n" +
+	"\vGetMessages\x12\x1b.chat.v1.GetMessagesRequest\x1a\x1c.chat.v1.GetMessagesResponse\x12K\n" +
+	"\fMarkRoomRead\x12\x1c.chat.v1.MarkRoomReadRequest\x1a\x1d.chat.v1.MarkRoomReadResponse\x12T\n" +
+	"\x0fCreateGroupRoom\x12\x1f.chat.v1.CreateGroupRoomRequest\x1a .chat.v1.CreateGroupRoomResponse\x12Q\n" +
+	"\x0eAddRoomMembers\x12\x1e.chat.v1.AddRoomMembersRequest\x1a\x1f.chat.v1.AddRoomMembersResponse\x12W\n" +
+	"\x10RemoveRoomMember\x12 .chat.v1.RemoveRoomMemberRequest\x1a!.chat.v1.RemoveRoomMemberResponse\x12E\n" +
+	"\n" +
+	"RenameRoom\x12\x1a.chat.v1.RenameRoomRequest\x1a\x1b.chat.v1.RenameRoomResponse\x12T\n" +
+	"\x0fListRoomMembers\x12\x1f.chat.v1.ListRoomMembersRequest\x1a .chat.v1.ListRoomMembersResponseB9Z7github.com/Dorazi23/gRPC_Chat_Project/pkg/chatpb;chatpbb\x06proto3"
 
 var (
 	file_proto_chat_proto_rawDescOnce sync.Once
@@ -362,28 +2286,91 @@ func file_proto_chat_proto_rawDescGZIP() []byte {
 	return file_proto_chat_proto_rawDescData
 }
 
-var file_proto_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_chat_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_proto_chat_proto_goTypes = []any{
-	(*ChatMessage)(nil),        // 0: chat.v1.ChatMessage
-	(*GetRoomIDRequest)(nil),   // 1: chat.v1.GetRoomIDRequest
-	(*GetRoomIDResponse)(nil),  // 2: chat.v1.GetRoomIDResponse
-	(*GetMyRoomsRequest)(nil),  // 3: chat.v1.GetMyRoomsRequest
-	(*ChatRoomInfo)(nil),       // 4: chat.v1.ChatRoomInfo
-	(*GetMyRoomsResponse)(nil), // 5: chat.v1.GetMyRoomsResponse
+	(PageDirection)(0),               // 0: chat.v1.PageDirection
+	(*ChatMessage)(nil),              // 1: chat.v1.ChatMessage
+	(*TypingEvent)(nil),              // 2: chat.v1.TypingEvent
+	(*MemberEvent)(nil),              // 3: chat.v1.MemberEvent
+	(*PresenceEvent)(nil),            // 4: chat.v1.PresenceEvent
+	(*ErrorEvent)(nil),               // 5: chat.v1.ErrorEvent
+	(*ReadReceiptEvent)(nil),         // 6: chat.v1.ReadReceiptEvent
+	(*MessageAckEvent)(nil),          // 7: chat.v1.MessageAckEvent
+	(*MessageNackEvent)(nil),         // 8: chat.v1.MessageNackEvent
+	(*ServerGoingAwayEvent)(nil),     // 9: chat.v1.ServerGoingAwayEvent
+	(*ChatClientEvent)(nil),          // 10: chat.v1.ChatClientEvent
+	(*ChatEvent)(nil),                // 11: chat.v1.ChatEvent
+	(*GetRoomIDRequest)(nil),         // 12: chat.v1.GetRoomIDRequest
+	(*GetRoomIDResponse)(nil),        // 13: chat.v1.GetRoomIDResponse
+	(*GetMyRoomsRequest)(nil),        // 14: chat.v1.GetMyRoomsRequest
+	(*ChatRoomInfo)(nil),             // 15: chat.v1.ChatRoomInfo
+	(*GetMyRoomsResponse)(nil),       // 16: chat.v1.GetMyRoomsResponse
+	(*GetMessagesRequest)(nil),       // 17: chat.v1.GetMessagesRequest
+	(*GetMessagesResponse)(nil),      // 18: chat.v1.GetMessagesResponse
+	(*MarkRoomReadRequest)(nil),      // 19: chat.v1.MarkRoomReadRequest
+	(*MarkRoomReadResponse)(nil),     // 20: chat.v1.MarkRoomReadResponse
+	(*CreateGroupRoomRequest)(nil),   // 21: chat.v1.CreateGroupRoomRequest
+	(*CreateGroupRoomResponse)(nil),  // 22: chat.v1.CreateGroupRoomResponse
+	(*AddRoomMembersRequest)(nil),    // 23: chat.v1.AddRoomMembersRequest
+	(*AddRoomMembersResponse)(nil),   // 24: chat.v1.AddRoomMembersResponse
+	(*RemoveRoomMemberRequest)(nil),  // 25: chat.v1.RemoveRoomMemberRequest
+	(*RemoveRoomMemberResponse)(nil), // 26: chat.v1.RemoveRoomMemberResponse
+	(*RenameRoomRequest)(nil),        // 27: chat.v1.RenameRoomRequest
+	(*RenameRoomResponse)(nil),       // 28: chat.v1.RenameRoomResponse
+	(*ListRoomMembersRequest)(nil),   // 29: chat.v1.ListRoomMembersRequest
+	(*RoomMember)(nil),               // 30: chat.v1.RoomMember
+	(*ListRoomMembersResponse)(nil),  // 31: chat.v1.ListRoomMembersResponse
+	(*timestamppb.Timestamp)(nil),    // 32: google.protobuf.Timestamp
 }
 var file_proto_chat_proto_depIdxs = []int32{
-	4, // 0: chat.v1.GetMyRoomsResponse.rooms:type_name -> chat.v1.ChatRoomInfo
-	0, // 1: chat.v1.ChatService.JoinChat:input_type -> chat.v1.ChatMessage
-	1, // 2: chat.v1.ChatService.GetRoomID:input_type -> chat.v1.GetRoomIDRequest
-	3, // 3: chat.v1.ChatService.GetMyRooms:input_type -> chat.v1.GetMyRoomsRequest
-	0, // 4: chat.v1.ChatService.JoinChat:output_type -> chat.v1.ChatMessage
-	2, // 5: chat.v1.ChatService.GetRoomID:output_type -> chat.v1.GetRoomIDResponse
-	5, // 6: chat.v1.ChatService.GetMyRooms:output_type -> chat.v1.GetMyRoomsResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	32, // 0: chat.v1.ChatMessage.sent_at:type_name -> google.protobuf.Timestamp
+	32, // 1: chat.v1.ReadReceiptEvent.read_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: chat.v1.MessageAckEvent.message:type_name -> chat.v1.ChatMessage
+	1,  // 3: chat.v1.ChatClientEvent.message:type_name -> chat.v1.ChatMessage
+	2,  // 4: chat.v1.ChatClientEvent.typing:type_name -> chat.v1.TypingEvent
+	1,  // 5: chat.v1.ChatEvent.message:type_name -> chat.v1.ChatMessage
+	2,  // 6: chat.v1.ChatEvent.typing:type_name -> chat.v1.TypingEvent
+	3,  // 7: chat.v1.ChatEvent.member_joined:type_name -> chat.v1.MemberEvent
+	3,  // 8: chat.v1.ChatEvent.member_left:type_name -> chat.v1.MemberEvent
+	4,  // 9: chat.v1.ChatEvent.presence:type_name -> chat.v1.PresenceEvent
+	5,  // 10: chat.v1.ChatEvent.error:type_name -> chat.v1.ErrorEvent
+	6,  // 11: chat.v1.ChatEvent.read_receipt:type_name -> chat.v1.ReadReceiptEvent
+	7,  // 12: chat.v1.ChatEvent.ack:type_name -> chat.v1.MessageAckEvent
+	8,  // 13: chat.v1.ChatEvent.nack:type_name -> chat.v1.MessageNackEvent
+	9,  // 14: chat.v1.ChatEvent.going_away:type_name -> chat.v1.ServerGoingAwayEvent
+	3,  // 15: chat.v1.ChatEvent.member_removed:type_name -> chat.v1.MemberEvent
+	32, // 16: chat.v1.ChatRoomInfo.last_message_at:type_name -> google.protobuf.Timestamp
+	32, // 17: chat.v1.ChatRoomInfo.last_activity_at:type_name -> google.protobuf.Timestamp
+	15, // 18: chat.v1.GetMyRoomsResponse.rooms:type_name -> chat.v1.ChatRoomInfo
+	0,  // 19: chat.v1.GetMessagesRequest.direction:type_name -> chat.v1.PageDirection
+	1,  // 20: chat.v1.GetMessagesResponse.messages:type_name -> chat.v1.ChatMessage
+	30, // 21: chat.v1.ListRoomMembersResponse.members:type_name -> chat.v1.RoomMember
+	10, // 22: chat.v1.ChatService.JoinChat:input_type -> chat.v1.ChatClientEvent
+	12, // 23: chat.v1.ChatService.GetRoomID:input_type -> chat.v1.GetRoomIDRequest
+	14, // 24: chat.v1.ChatService.GetMyRooms:input_type -> chat.v1.GetMyRoomsRequest
+	17, // 25: chat.v1.ChatService.GetMessages:input_type -> chat.v1.GetMessagesRequest
+	19, // 26: chat.v1.ChatService.MarkRoomRead:input_type -> chat.v1.MarkRoomReadRequest
+	21, // 27: chat.v1.ChatService.CreateGroupRoom:input_type -> chat.v1.CreateGroupRoomRequest
+	23, // 28: chat.v1.ChatService.AddRoomMembers:input_type -> chat.v1.AddRoomMembersRequest
+	25, // 29: chat.v1.ChatService.RemoveRoomMember:input_type -> chat.v1.RemoveRoomMemberRequest
+	27, // 30: chat.v1.ChatService.RenameRoom:input_type -> chat.v1.RenameRoomRequest
+	29, // 31: chat.v1.ChatService.ListRoomMembers:input_type -> chat.v1.ListRoomMembersRequest
+	11, // 32: chat.v1.ChatService.JoinChat:output_type -> chat.v1.ChatEvent
+	13, // 33: chat.v1.ChatService.GetRoomID:output_type -> chat.v1.GetRoomIDResponse
+	16, // 34: chat.v1.ChatService.GetMyRooms:output_type -> chat.v1.GetMyRoomsResponse
+	18, // 35: chat.v1.ChatService.GetMessages:output_type -> chat.v1.GetMessagesResponse
+	20, // 36: chat.v1.ChatService.MarkRoomRead:output_type -> chat.v1.MarkRoomReadResponse
+	22, // 37: chat.v1.ChatService.CreateGroupRoom:output_type -> chat.v1.CreateGroupRoomResponse
+	24, // 38: chat.v1.ChatService.AddRoomMembers:output_type -> chat.v1.AddRoomMembersResponse
+	26, // 39: chat.v1.ChatService.RemoveRoomMember:output_type -> chat.v1.RemoveRoomMemberResponse
+	28, // 40: chat.v1.ChatService.RenameRoom:output_type -> chat.v1.RenameRoomResponse
+	31, // 41: chat.v1.ChatService.ListRoomMembers:output_type -> chat.v1.ListRoomMembersResponse
+	32, // [32:42] is the sub-list for method output_type
+	22, // [22:32] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_proto_chat_proto_init() }
@@ -391,18 +2378,36 @@ func file_proto_chat_proto_init() {
 	if File_proto_chat_proto != nil {
 		return
 	}
+	file_proto_chat_proto_msgTypes[9].OneofWrappers = []any{
+		(*ChatClientEvent_Message)(nil),
+		(*ChatClientEvent_Typing)(nil),
+	}
+	file_proto_chat_proto_msgTypes[10].OneofWrappers = []any{
+		(*ChatEvent_Message)(nil),
+		(*ChatEvent_Typing)(nil),
+		(*ChatEvent_MemberJoined)(nil),
+		(*ChatEvent_MemberLeft)(nil),
+		(*ChatEvent_Presence)(nil),
+		(*ChatEvent_Error)(nil),
+		(*ChatEvent_ReadReceipt)(nil),
+		(*ChatEvent_Ack)(nil),
+		(*ChatEvent_Nack)(nil),
+		(*ChatEvent_GoingAway)(nil),
+		(*ChatEvent_MemberRemoved)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_chat_proto_rawDesc), len(file_proto_chat_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_chat_proto_goTypes,
 		DependencyIndexes: file_proto_chat_proto_depIdxs,
+		EnumInfos:         file_proto_chat_proto_enumTypes,
 		MessageInfos:      file_proto_chat_proto_msgTypes,
 	}.Build()
 	File_proto_chat_proto = out.File
@@ -19,9 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ChatService_JoinChat_FullMethodName   = "/chat.v1.ChatService/JoinChat"
-	ChatService_GetRoomID_FullMethodName  = "/chat.v1.ChatService/GetRoomID"
-	ChatService_GetMyRooms_FullMethodName = "/chat.v1.ChatService/GetMyRooms"
+	ChatService_JoinChat_FullMethodName         = "/chat.v1.ChatService/JoinChat"
+	ChatService_GetRoomID_FullMethodName        = "/chat.v1.ChatService/GetRoomID"
+	ChatService_GetMyRooms_FullMethodName       = "/chat.v1.ChatService/GetMyRooms"
+	ChatService_GetMessages_FullMethodName      = "/chat.v1.ChatService/GetMessages"
+	ChatService_MarkRoomRead_FullMethodName     = "/chat.v1.ChatService/MarkRoomRead"
+	ChatService_CreateGroupRoom_FullMethodName  = "/chat.v1.ChatService/CreateGroupRoom"
+	ChatService_AddRoomMembers_FullMethodName   = "/chat.v1.ChatService/AddRoomMembers"
+	ChatService_RemoveRoomMember_FullMethodName = "/chat.v1.ChatService/RemoveRoomMember"
+	ChatService_RenameRoom_FullMethodName       = "/chat.v1.ChatService/RenameRoom"
+	ChatService_ListRoomMembers_FullMethodName  = "/chat.v1.ChatService/ListRoomMembers"
 )
 
 // ChatServiceClient is the client API for ChatService service.
@@ -31,11 +38,21 @@ const (
 // 채팅 서비스 정의
 type ChatServiceClient interface {
 	// 양방향 스트리밍 RPC
-	JoinChat(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ChatMessage, ChatMessage], error)
+	JoinChat(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ChatClientEvent, ChatEvent], error)
 	// 방 ID 발급 API
 	GetRoomID(ctx context.Context, in *GetRoomIDRequest, opts ...grpc.CallOption) (*GetRoomIDResponse, error)
 	// [추가] 내 채팅방 목록 조회 API
 	GetMyRooms(ctx context.Context, in *GetMyRoomsRequest, opts ...grpc.CallOption) (*GetMyRoomsResponse, error)
+	// 메시지 기록 조회 (cursor 기반 페이지네이션)
+	GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
+	// 읽음 처리 (방 접속자에게 ReadReceiptEvent 브로드캐스트)
+	MarkRoomRead(ctx context.Context, in *MarkRoomReadRequest, opts ...grpc.CallOption) (*MarkRoomReadResponse, error)
+	// 그룹 채팅방
+	CreateGroupRoom(ctx context.Context, in *CreateGroupRoomRequest, opts ...grpc.CallOption) (*CreateGroupRoomResponse, error)
+	AddRoomMembers(ctx context.Context, in *AddRoomMembersRequest, opts ...grpc.CallOption) (*AddRoomMembersResponse, error)
+	RemoveRoomMember(ctx context.Context, in *RemoveRoomMemberRequest, opts ...grpc.CallOption) (*RemoveRoomMemberResponse, error)
+	RenameRoom(ctx context.Context, in *RenameRoomRequest, opts ...grpc.CallOption) (*RenameRoomResponse, error)
+	ListRoomMembers(ctx context.Context, in *ListRoomMembersRequest, opts ...grpc.CallOption) (*ListRoomMembersResponse, error)
 }
 
 type chatServiceClient struct {
@@ -46,18 +63,18 @@ func NewChatServiceClient(cc grpc.ClientConnInterface) ChatServiceClient {
 	return &chatServiceClient{cc}
 }
 
-func (c *chatServiceClient) JoinChat(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ChatMessage, ChatMessage], error) {
+func (c *chatServiceClient) JoinChat(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ChatClientEvent, ChatEvent], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &ChatService_ServiceDesc.Streams[0], ChatService_JoinChat_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[ChatMessage, ChatMessage]{ClientStream: stream}
+	x := &grpc.GenericClientStream[ChatClientEvent, ChatEvent]{ClientStream: stream}
 	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ChatService_JoinChatClient = grpc.BidiStreamingClient[ChatMessage, ChatMessage]
+type ChatService_JoinChatClient = grpc.BidiStreamingClient[ChatClientEvent, ChatEvent]
 
 func (c *chatServiceClient) GetRoomID(ctx context.Context, in *GetRoomIDRequest, opts ...grpc.CallOption) (*GetRoomIDResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
@@ -79,6 +96,76 @@ func (c *chatServiceClient) GetMyRooms(ctx context.Context, in *GetMyRoomsReques
 	return out, nil
 }
 
+func (c *chatServiceClient) GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMessagesResponse)
+	err := c.cc.Invoke(ctx, ChatService_GetMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) MarkRoomRead(ctx context.Context, in *MarkRoomReadRequest, opts ...grpc.CallOption) (*MarkRoomReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkRoomReadResponse)
+	err := c.cc.Invoke(ctx, ChatService_MarkRoomRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) CreateGroupRoom(ctx context.Context, in *CreateGroupRoomRequest, opts ...grpc.CallOption) (*CreateGroupRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupRoomResponse)
+	err := c.cc.Invoke(ctx, ChatService_CreateGroupRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) AddRoomMembers(ctx context.Context, in *AddRoomMembersRequest, opts ...grpc.CallOption) (*AddRoomMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddRoomMembersResponse)
+	err := c.cc.Invoke(ctx, ChatService_AddRoomMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) RemoveRoomMember(ctx context.Context, in *RemoveRoomMemberRequest, opts ...grpc.CallOption) (*RemoveRoomMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveRoomMemberResponse)
+	err := c.cc.Invoke(ctx, ChatService_RemoveRoomMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) RenameRoom(ctx context.Context, in *RenameRoomRequest, opts ...grpc.CallOption) (*RenameRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameRoomResponse)
+	err := c.cc.Invoke(ctx, ChatService_RenameRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ListRoomMembers(ctx context.Context, in *ListRoomMembersRequest, opts ...grpc.CallOption) (*ListRoomMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoomMembersResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListRoomMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServiceServer is the server API for ChatService service.
 // All implementations must embed UnimplementedChatServiceServer
 // for forward compatibility.
@@ -86,11 +173,21 @@ func (c *chatServiceClient) GetMyRooms(ctx context.Context, in *GetMyRoomsReques
 // 채팅 서비스 정의
 type ChatServiceServer interface {
 	// 양방향 스트리밍 RPC
-	JoinChat(grpc.BidiStreamingServer[ChatMessage, ChatMessage]) error
+	JoinChat(grpc.BidiStreamingServer[ChatClientEvent, ChatEvent]) error
 	// 방 ID 발급 API
 	GetRoomID(context.Context, *GetRoomIDRequest) (*GetRoomIDResponse, error)
 	// [추가] 내 채팅방 목록 조회 API
 	GetMyRooms(context.Context, *GetMyRoomsRequest) (*GetMyRoomsResponse, error)
+	// 메시지 기록 조회 (cursor 기반 페이지네이션)
+	GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesResponse, error)
+	// 읽음 처리 (방 접속자에게 ReadReceiptEvent 브로드캐스트)
+	MarkRoomRead(context.Context, *MarkRoomReadRequest) (*MarkRoomReadResponse, error)
+	// 그룹 채팅방
+	CreateGroupRoom(context.Context, *CreateGroupRoomRequest) (*CreateGroupRoomResponse, error)
+	AddRoomMembers(context.Context, *AddRoomMembersRequest) (*AddRoomMembersResponse, error)
+	RemoveRoomMember(context.Context, *RemoveRoomMemberRequest) (*RemoveRoomMemberResponse, error)
+	RenameRoom(context.Context, *RenameRoomRequest) (*RenameRoomResponse, error)
+	ListRoomMembers(context.Context, *ListRoomMembersRequest) (*ListRoomMembersResponse, error)
 	mustEmbedUnimplementedChatServiceServer()
 }
 
@@ -101,7 +198,7 @@ type ChatServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedChatServiceServer struct{}
 
-func (UnimplementedChatServiceServer) JoinChat(grpc.BidiStreamingServer[ChatMessage, ChatMessage]) error {
+func (UnimplementedChatServiceServer) JoinChat(grpc.BidiStreamingServer[ChatClientEvent, ChatEvent]) error {
 	return status.Errorf(codes.Unimplemented, "method JoinChat not implemented")
 }
 func (UnimplementedChatServiceServer) GetRoomID(context.Context, *GetRoomIDRequest) (*GetRoomIDResponse, error) {
@@ -110,6 +207,27 @@ func (UnimplementedChatServiceServer) GetRoomID(context.Context, *GetRoomIDReque
 func (UnimplementedChatServiceServer) GetMyRooms(context.Context, *GetMyRoomsRequest) (*GetMyRoomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMyRooms not implemented")
 }
+func (UnimplementedChatServiceServer) GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessages not implemented")
+}
+func (UnimplementedChatServiceServer) MarkRoomRead(context.Context, *MarkRoomReadRequest) (*MarkRoomReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRoomRead not implemented")
+}
+func (UnimplementedChatServiceServer) CreateGroupRoom(context.Context, *CreateGroupRoomRequest) (*CreateGroupRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupRoom not implemented")
+}
+func (UnimplementedChatServiceServer) AddRoomMembers(context.Context, *AddRoomMembersRequest) (*AddRoomMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRoomMembers not implemented")
+}
+func (UnimplementedChatServiceServer) RemoveRoomMember(context.Context, *RemoveRoomMemberRequest) (*RemoveRoomMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoomMember not implemented")
+}
+func (UnimplementedChatServiceServer) RenameRoom(context.Context, *RenameRoomRequest) (*RenameRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameRoom not implemented")
+}
+func (UnimplementedChatServiceServer) ListRoomMembers(context.Context, *ListRoomMembersRequest) (*ListRoomMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoomMembers not implemented")
+}
 func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
 func (UnimplementedChatServiceServer) testEmbeddedByValue()                     {}
 
@@ -132,11 +250,11 @@ func RegisterChatServiceServer(s grpc.ServiceRegistrar, srv ChatServiceServer) {
 }
 
 func _ChatService_JoinChat_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(ChatServiceServer).JoinChat(&grpc.GenericServerStream[ChatMessage, ChatMessage]{ServerStream: stream})
+	return srv.(ChatServiceServer).JoinChat(&grpc.GenericServerStream[ChatClientEvent, ChatEvent]{ServerStream: stream})
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ChatService_JoinChatServer = grpc.BidiStreamingServer[ChatMessage, ChatMessage]
+type ChatService_JoinChatServer = grpc.BidiStreamingServer[ChatClientEvent, ChatEvent]
 
 func _ChatService_GetRoomID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRoomIDRequest)
@@ -174,6 +292,132 @@ func _ChatService_GetMyRooms_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_GetMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetMessages(ctx, req.(*GetMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_MarkRoomRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkRoomReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).MarkRoomRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_MarkRoomRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).MarkRoomRead(ctx, req.(*MarkRoomReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_CreateGroupRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).CreateGroupRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_CreateGroupRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).CreateGroupRoom(ctx, req.(*CreateGroupRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_AddRoomMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRoomMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).AddRoomMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_AddRoomMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).AddRoomMembers(ctx, req.(*AddRoomMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RemoveRoomMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRoomMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RemoveRoomMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_RemoveRoomMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RemoveRoomMember(ctx, req.(*RemoveRoomMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RenameRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RenameRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_RenameRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RenameRoom(ctx, req.(*RenameRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListRoomMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListRoomMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListRoomMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListRoomMembers(ctx, req.(*ListRoomMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +433,34 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMyRooms",
 			Handler:    _ChatService_GetMyRooms_Handler,
 		},
+		{
+			MethodName: "GetMessages",
+			Handler:    _ChatService_GetMessages_Handler,
+		},
+		{
+			MethodName: "MarkRoomRead",
+			Handler:    _ChatService_MarkRoomRead_Handler,
+		},
+		{
+			MethodName: "CreateGroupRoom",
+			Handler:    _ChatService_CreateGroupRoom_Handler,
+		},
+		{
+			MethodName: "AddRoomMembers",
+			Handler:    _ChatService_AddRoomMembers_Handler,
+		},
+		{
+			MethodName: "RemoveRoomMember",
+			Handler:    _ChatService_RemoveRoomMember_Handler,
+		},
+		{
+			MethodName: "RenameRoom",
+			Handler:    _ChatService_RenameRoom_Handler,
+		},
+		{
+			MethodName: "ListRoomMembers",
+			Handler:    _ChatService_ListRoomMembers_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
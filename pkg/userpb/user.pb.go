@@ -22,7 +22,7 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// 나중에 카카오/네이버용 (추후 구현 할 떄 사용)
+// 카카오/네이버 소셜 로그인 제공자
 type SocialProvider int32
 
 const (
@@ -499,10 +499,11 @@ func (x *VerifyPhoneRequest) GetCode() string {
 }
 
 type VerifyPhoneResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	VerificationId string                 `protobuf:"bytes,2,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"` // 인증에 성공하면 SignUp / UpdateProfile의 phone_verification_id로 보냄 (한 번만 사용 가능)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *VerifyPhoneResponse) Reset() {
@@ -542,16 +543,24 @@ func (x *VerifyPhoneResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *VerifyPhoneResponse) GetVerificationId() string {
+	if x != nil {
+		return x.VerificationId
+	}
+	return ""
+}
+
 // ====== 회원가입 / 로그인 ======
 type SignUpRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // 아이디
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`         // 실명
-	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"` // 비밀번호 (재입력은 프론트에서 확인 후 이 값만 보냄)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Username            string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // 아이디
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`         // 실명
+	Phone               string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email               string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Password            string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`                                                    // 비밀번호 (재입력은 프론트에서 확인 후 이 값만 보냄)
+	PhoneVerificationId string                 `protobuf:"bytes,6,opt,name=phone_verification_id,json=phoneVerificationId,proto3" json:"phone_verification_id,omitempty"` // phone을 인증한 VerifyPhone의 verification_id (있으면 phone_verified로 가입)
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *SignUpRequest) Reset() {
@@ -619,6 +628,13 @@ func (x *SignUpRequest) GetPassword() string {
 	return ""
 }
 
+func (x *SignUpRequest) GetPhoneVerificationId() string {
+	if x != nil {
+		return x.PhoneVerificationId
+	}
+	return ""
+}
+
 type SignUpResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -717,8 +733,8 @@ func (x *LoginRequest) GetPassword() string {
 
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`    // JWT 같은 토큰
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // 나중에 안 쓰면 비워둬도 됨
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`    // JWT (짧은 유효 기간)
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // RefreshToken으로 새 토큰을 받을 때 쓰는 불투명 토큰 (한 번만 쓸 수 있음)
 	User          *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -827,6 +843,7 @@ func (x *SocialLoginRequest) GetAccessToken() string {
 	return ""
 }
 
+// 처음 로그인한 소셜 계정이면 유저를 새로 만들어서 연결함
 type SocialLoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
@@ -887,6 +904,585 @@ func (x *SocialLoginResponse) GetUser() *User {
 	return nil
 }
 
+// ====== 소셜 계정 연결 ======
+type SocialIdentity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      SocialProvider         `protobuf:"varint,1,opt,name=provider,proto3,enum=user.v1.SocialProvider" json:"provider,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                        // 제공자가 알려준 이메일 (없으면 빈 값)
+	LinkedAt      int64                  `protobuf:"varint,3,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"` // 연결 시각 (unix timestamp)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SocialIdentity) Reset() {
+	*x = SocialIdentity{}
+	mi := &file_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SocialIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocialIdentity) ProtoMessage() {}
+
+func (x *SocialIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocialIdentity.ProtoReflect.Descriptor instead.
+func (*SocialIdentity) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SocialIdentity) GetProvider() SocialProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return SocialProvider_SOCIAL_PROVIDER_UNSPECIFIED
+}
+
+func (x *SocialIdentity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SocialIdentity) GetLinkedAt() int64 {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return 0
+}
+
+// 로그인한 내 계정에 소셜 계정을 연결
+type LinkSocialAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      SocialProvider         `protobuf:"varint,1,opt,name=provider,proto3,enum=user.v1.SocialProvider" json:"provider,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"` // 카카오/네이버에서 받은 토큰
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkSocialAccountRequest) Reset() {
+	*x = LinkSocialAccountRequest{}
+	mi := &file_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkSocialAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkSocialAccountRequest) ProtoMessage() {}
+
+func (x *LinkSocialAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkSocialAccountRequest.ProtoReflect.Descriptor instead.
+func (*LinkSocialAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LinkSocialAccountRequest) GetProvider() SocialProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return SocialProvider_SOCIAL_PROVIDER_UNSPECIFIED
+}
+
+func (x *LinkSocialAccountRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type LinkSocialAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*SocialIdentity      `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"` // 연결 후 내 소셜 계정 목록
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkSocialAccountResponse) Reset() {
+	*x = LinkSocialAccountResponse{}
+	mi := &file_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkSocialAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkSocialAccountResponse) ProtoMessage() {}
+
+func (x *LinkSocialAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkSocialAccountResponse.ProtoReflect.Descriptor instead.
+func (*LinkSocialAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LinkSocialAccountResponse) GetIdentities() []*SocialIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+// 소셜 계정 연결 해제 (비밀번호가 없는 계정의 마지막 소셜 계정은 해제할 수 없음)
+type UnlinkSocialAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      SocialProvider         `protobuf:"varint,1,opt,name=provider,proto3,enum=user.v1.SocialProvider" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkSocialAccountRequest) Reset() {
+	*x = UnlinkSocialAccountRequest{}
+	mi := &file_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkSocialAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkSocialAccountRequest) ProtoMessage() {}
+
+func (x *UnlinkSocialAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkSocialAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkSocialAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UnlinkSocialAccountRequest) GetProvider() SocialProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return SocialProvider_SOCIAL_PROVIDER_UNSPECIFIED
+}
+
+type UnlinkSocialAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*SocialIdentity      `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkSocialAccountResponse) Reset() {
+	*x = UnlinkSocialAccountResponse{}
+	mi := &file_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkSocialAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkSocialAccountResponse) ProtoMessage() {}
+
+func (x *UnlinkSocialAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkSocialAccountResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkSocialAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnlinkSocialAccountResponse) GetIdentities() []*SocialIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+type ListSocialAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSocialAccountsRequest) Reset() {
+	*x = ListSocialAccountsRequest{}
+	mi := &file_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSocialAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSocialAccountsRequest) ProtoMessage() {}
+
+func (x *ListSocialAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSocialAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListSocialAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
+}
+
+type ListSocialAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*SocialIdentity      `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSocialAccountsResponse) Reset() {
+	*x = ListSocialAccountsResponse{}
+	mi := &file_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSocialAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSocialAccountsResponse) ProtoMessage() {}
+
+func (x *ListSocialAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSocialAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListSocialAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListSocialAccountsResponse) GetIdentities() []*SocialIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+// ====== 토큰 / 로그아웃 ======
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// 보낸 refresh token은 더 이상 못 씀. 같은 토큰을 다시 보내면 탈취로 보고 그 세션 전체를 끊음
+type RefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // 새 refresh token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RefreshTokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// 현재 access token의 세션을 끊음
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{24}
+}
+
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{25}
+}
+
+// 내 모든 세션(다른 기기 포함)을 끊음
+type LogoutAllDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutAllDevicesRequest) Reset() {
+	*x = LogoutAllDevicesRequest{}
+	mi := &file_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutAllDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutAllDevicesRequest) ProtoMessage() {}
+
+func (x *LogoutAllDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutAllDevicesRequest.ProtoReflect.Descriptor instead.
+func (*LogoutAllDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{26}
+}
+
+type LogoutAllDevicesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RevokedSessions int32                  `protobuf:"varint,1,opt,name=revoked_sessions,json=revokedSessions,proto3" json:"revoked_sessions,omitempty"` // 끊은 세션 수
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LogoutAllDevicesResponse) Reset() {
+	*x = LogoutAllDevicesResponse{}
+	mi := &file_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutAllDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutAllDevicesResponse) ProtoMessage() {}
+
+func (x *LogoutAllDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutAllDevicesResponse.ProtoReflect.Descriptor instead.
+func (*LogoutAllDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LogoutAllDevicesResponse) GetRevokedSessions() int32 {
+	if x != nil {
+		return x.RevokedSessions
+	}
+	return 0
+}
+
 // ====== 프로필 ======
 type GetProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -897,7 +1493,7 @@ type GetProfileRequest struct {
 
 func (x *GetProfileRequest) Reset() {
 	*x = GetProfileRequest{}
-	mi := &file_user_proto_msgTypes[15]
+	mi := &file_user_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -909,7 +1505,7 @@ func (x *GetProfileRequest) String() string {
 func (*GetProfileRequest) ProtoMessage() {}
 
 func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[15]
+	mi := &file_user_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1518,7 @@ func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetProfileRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{15}
+	return file_user_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetProfileRequest) GetUserId() string {
@@ -941,7 +1537,7 @@ type GetProfileResponse struct {
 
 func (x *GetProfileResponse) Reset() {
 	*x = GetProfileResponse{}
-	mi := &file_user_proto_msgTypes[16]
+	mi := &file_user_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -953,7 +1549,7 @@ func (x *GetProfileResponse) String() string {
 func (*GetProfileResponse) ProtoMessage() {}
 
 func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[16]
+	mi := &file_user_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -966,7 +1562,7 @@ func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
 func (*GetProfileResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{16}
+	return file_user_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetProfileResponse) GetUser() *User {
@@ -977,18 +1573,19 @@ func (x *GetProfileResponse) GetUser() *User {
 }
 
 type UpdateProfileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Nickname            string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Phone               string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email               string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	PhoneVerificationId string                 `protobuf:"bytes,5,opt,name=phone_verification_id,json=phoneVerificationId,proto3" json:"phone_verification_id,omitempty"` // 바꿀 번호(비어 있으면 지금 번호)를 인증한 verification_id
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *UpdateProfileRequest) Reset() {
 	*x = UpdateProfileRequest{}
-	mi := &file_user_proto_msgTypes[17]
+	mi := &file_user_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1000,7 +1597,7 @@ func (x *UpdateProfileRequest) String() string {
 func (*UpdateProfileRequest) ProtoMessage() {}
 
 func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[17]
+	mi := &file_user_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1013,7 +1610,7 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{17}
+	return file_user_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UpdateProfileRequest) GetName() string {
@@ -1044,6 +1641,13 @@ func (x *UpdateProfileRequest) GetEmail() string {
 	return ""
 }
 
+func (x *UpdateProfileRequest) GetPhoneVerificationId() string {
+	if x != nil {
+		return x.PhoneVerificationId
+	}
+	return ""
+}
+
 type UpdateProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -1053,7 +1657,7 @@ type UpdateProfileResponse struct {
 
 func (x *UpdateProfileResponse) Reset() {
 	*x = UpdateProfileResponse{}
-	mi := &file_user_proto_msgTypes[18]
+	mi := &file_user_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1065,7 +1669,7 @@ func (x *UpdateProfileResponse) String() string {
 func (*UpdateProfileResponse) ProtoMessage() {}
 
 func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[18]
+	mi := &file_user_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1078,7 +1682,7 @@ func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{18}
+	return file_user_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UpdateProfileResponse) GetUser() *User {
@@ -1098,7 +1702,7 @@ type ChangePasswordRequest struct {
 
 func (x *ChangePasswordRequest) Reset() {
 	*x = ChangePasswordRequest{}
-	mi := &file_user_proto_msgTypes[19]
+	mi := &file_user_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1110,7 +1714,7 @@ func (x *ChangePasswordRequest) String() string {
 func (*ChangePasswordRequest) ProtoMessage() {}
 
 func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[19]
+	mi := &file_user_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1123,7 +1727,7 @@ func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{19}
+	return file_user_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ChangePasswordRequest) GetCurrentPassword() string {
@@ -1148,7 +1752,7 @@ type ChangePasswordResponse struct {
 
 func (x *ChangePasswordResponse) Reset() {
 	*x = ChangePasswordResponse{}
-	mi := &file_user_proto_msgTypes[20]
+	mi := &file_user_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1160,7 +1764,7 @@ func (x *ChangePasswordResponse) String() string {
 func (*ChangePasswordResponse) ProtoMessage() {}
 
 func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[20]
+	mi := &file_user_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1173,7 +1777,7 @@ func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
 func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{20}
+	return file_user_proto_rawDescGZIP(), []int{33}
 }
 
 type UpdateAvatarRequest struct {
@@ -1185,7 +1789,7 @@ type UpdateAvatarRequest struct {
 
 func (x *UpdateAvatarRequest) Reset() {
 	*x = UpdateAvatarRequest{}
-	mi := &file_user_proto_msgTypes[21]
+	mi := &file_user_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1197,7 +1801,7 @@ func (x *UpdateAvatarRequest) String() string {
 func (*UpdateAvatarRequest) ProtoMessage() {}
 
 func (x *UpdateAvatarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[21]
+	mi := &file_user_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1210,7 +1814,7 @@ func (x *UpdateAvatarRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAvatarRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAvatarRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{21}
+	return file_user_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *UpdateAvatarRequest) GetAvatarUrl() string {
@@ -1229,7 +1833,7 @@ type UpdateAvatarResponse struct {
 
 func (x *UpdateAvatarResponse) Reset() {
 	*x = UpdateAvatarResponse{}
-	mi := &file_user_proto_msgTypes[22]
+	mi := &file_user_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1241,7 +1845,7 @@ func (x *UpdateAvatarResponse) String() string {
 func (*UpdateAvatarResponse) ProtoMessage() {}
 
 func (x *UpdateAvatarResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[22]
+	mi := &file_user_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1254,7 +1858,7 @@ func (x *UpdateAvatarResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAvatarResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAvatarResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{22}
+	return file_user_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *UpdateAvatarResponse) GetUser() *User {
@@ -1276,7 +1880,7 @@ type SearchUsersRequest struct {
 
 func (x *SearchUsersRequest) Reset() {
 	*x = SearchUsersRequest{}
-	mi := &file_user_proto_msgTypes[23]
+	mi := &file_user_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1288,7 +1892,7 @@ func (x *SearchUsersRequest) String() string {
 func (*SearchUsersRequest) ProtoMessage() {}
 
 func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[23]
+	mi := &file_user_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1301,7 +1905,7 @@ func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
 func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{23}
+	return file_user_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *SearchUsersRequest) GetQuery() string {
@@ -1334,7 +1938,7 @@ type SearchUsersResponse struct {
 
 func (x *SearchUsersResponse) Reset() {
 	*x = SearchUsersResponse{}
-	mi := &file_user_proto_msgTypes[24]
+	mi := &file_user_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1346,7 +1950,7 @@ func (x *SearchUsersResponse) String() string {
 func (*SearchUsersResponse) ProtoMessage() {}
 
 func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[24]
+	mi := &file_user_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1359,7 +1963,7 @@ func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
 func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{24}
+	return file_user_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *SearchUsersResponse) GetUsers() []*User {
@@ -1401,15 +2005,17 @@ const file_user_proto_rawDesc = "" +
 	"\x0fverification_id\x18\x01 \x01(\tR\x0everificationId\"Q\n" +
 	"\x12VerifyPhoneRequest\x12'\n" +
 	"\x0fverification_id\x18\x01 \x01(\tR\x0everificationId\x12\x12\n" +
-	"\x04code\x18\x02 \x01(\tR\x04code\"/\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"X\n" +
 	"\x13VerifyPhoneResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x87\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12'\n" +
+	"\x0fverification_id\x18\x02 \x01(\tR\x0everificationId\"\xbb\x01\n" +
 	"\rSignUpRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
 	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x05 \x01(\tR\bpassword\"3\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x122\n" +
+	"\x15phone_verification_id\x18\x06 \x01(\tR\x13phoneVerificationId\"3\n" +
 	"\x0eSignUpResponse\x12!\n" +
 	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"F\n" +
 	"\fLoginRequest\x12\x1a\n" +
@@ -1425,16 +2031,49 @@ const file_user_proto_rawDesc = "" +
 	"\x13SocialLoginResponse\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12!\n" +
-	"\x04user\x18\x03 \x01(\v2\r.user.v1.UserR\x04user\",\n" +
+	"\x04user\x18\x03 \x01(\v2\r.user.v1.UserR\x04user\"x\n" +
+	"\x0eSocialIdentity\x123\n" +
+	"\bprovider\x18\x01 \x01(\x0e2\x17.user.v1.SocialProviderR\bprovider\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
+	"\tlinked_at\x18\x03 \x01(\x03R\blinkedAt\"r\n" +
+	"\x18LinkSocialAccountRequest\x123\n" +
+	"\bprovider\x18\x01 \x01(\x0e2\x17.user.v1.SocialProviderR\bprovider\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\"T\n" +
+	"\x19LinkSocialAccountResponse\x127\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x17.user.v1.SocialIdentityR\n" +
+	"identities\"Q\n" +
+	"\x1aUnlinkSocialAccountRequest\x123\n" +
+	"\bprovider\x18\x01 \x01(\x0e2\x17.user.v1.SocialProviderR\bprovider\"V\n" +
+	"\x1bUnlinkSocialAccountResponse\x127\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x17.user.v1.SocialIdentityR\n" +
+	"identities\"\x1b\n" +
+	"\x19ListSocialAccountsRequest\"U\n" +
+	"\x1aListSocialAccountsResponse\x127\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x17.user.v1.SocialIdentityR\n" +
+	"identities\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"^\n" +
+	"\x14RefreshTokenResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"\x0f\n" +
+	"\rLogoutRequest\"\x10\n" +
+	"\x0eLogoutResponse\"\x19\n" +
+	"\x17LogoutAllDevicesRequest\"E\n" +
+	"\x18LogoutAllDevicesResponse\x12)\n" +
+	"\x10revoked_sessions\x18\x01 \x01(\x05R\x0frevokedSessions\",\n" +
 	"\x11GetProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"7\n" +
 	"\x12GetProfileResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"r\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"\xa6\x01\n" +
 	"\x14UpdateProfileRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x14\n" +
 	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
-	"\x05email\x18\x04 \x01(\tR\x05email\":\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x122\n" +
+	"\x15phone_verification_id\x18\x05 \x01(\tR\x13phoneVerificationId\":\n" +
 	"\x15UpdateProfileResponse\x12!\n" +
 	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"e\n" +
 	"\x15ChangePasswordRequest\x12)\n" +
@@ -1455,7 +2094,7 @@ const file_user_proto_rawDesc = "" +
 	"\x0eSocialProvider\x12\x1f\n" +
 	"\x1bSOCIAL_PROVIDER_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15SOCIAL_PROVIDER_KAKAO\x10\x01\x12\x19\n" +
-	"\x15SOCIAL_PROVIDER_NAVER\x10\x022\x9d\a\n" +
+	"\x15SOCIAL_PROVIDER_NAVER\x10\x022\x9b\v\n" +
 	"\vUserService\x12N\n" +
 	"\rCheckUsername\x12\x1d.user.v1.CheckUsernameRequest\x1a\x1e.user.v1.CheckUsernameResponse\x12E\n" +
 	"\n" +
@@ -1464,7 +2103,13 @@ const file_user_proto_rawDesc = "" +
 	"\vVerifyPhone\x12\x1b.user.v1.VerifyPhoneRequest\x1a\x1c.user.v1.VerifyPhoneResponse\x129\n" +
 	"\x06SignUp\x12\x16.user.v1.SignUpRequest\x1a\x17.user.v1.SignUpResponse\x126\n" +
 	"\x05Login\x12\x15.user.v1.LoginRequest\x1a\x16.user.v1.LoginResponse\x12H\n" +
-	"\vSocialLogin\x12\x1b.user.v1.SocialLoginRequest\x1a\x1c.user.v1.SocialLoginResponse\x12E\n" +
+	"\vSocialLogin\x12\x1b.user.v1.SocialLoginRequest\x1a\x1c.user.v1.SocialLoginResponse\x12Z\n" +
+	"\x11LinkSocialAccount\x12!.user.v1.LinkSocialAccountRequest\x1a\".user.v1.LinkSocialAccountResponse\x12`\n" +
+	"\x13UnlinkSocialAccount\x12#.user.v1.UnlinkSocialAccountRequest\x1a$.user.v1.UnlinkSocialAccountResponse\x12]\n" +
+	"\x12ListSocialAccounts\x12\".user.v1.ListSocialAccountsRequest\x1a#.user.v1.ListSocialAccountsResponse\x12K\n" +
+	"\fRefreshToken\x12\x1c.user.v1.RefreshTokenRequest\x1a\x1d.user.v1.RefreshTokenResponse\x129\n" +
+	"\x06Logout\x12\x16.user.v1.LogoutRequest\x1a\x17.user.v1.LogoutResponse\x12W\n" +
+	"\x10LogoutAllDevices\x12 .user.v1.LogoutAllDevicesRequest\x1a!.user.v1.LogoutAllDevicesResponse\x12E\n" +
 	"\n" +
 	"GetProfile\x12\x1a.user.v1.GetProfileRequest\x1a\x1b.user.v1.GetProfileResponse\x12N\n" +
 	"\rUpdateProfile\x12\x1d.user.v1.UpdateProfileRequest\x1a\x1e.user.v1.UpdateProfileResponse\x12Q\n" +
@@ -1485,7 +2130,7 @@ func file_user_proto_rawDescGZIP() []byte {
 }
 
 var file_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_user_proto_goTypes = []any{
 	(SocialProvider)(0),                      // 0: user.v1.SocialProvider
 	(*User)(nil),                             // 1: user.v1.User
@@ -1503,55 +2148,86 @@ var file_user_proto_goTypes = []any{
 	(*LoginResponse)(nil),                    // 13: user.v1.LoginResponse
 	(*SocialLoginRequest)(nil),               // 14: user.v1.SocialLoginRequest
 	(*SocialLoginResponse)(nil),              // 15: user.v1.SocialLoginResponse
-	(*GetProfileRequest)(nil),                // 16: user.v1.GetProfileRequest
-	(*GetProfileResponse)(nil),               // 17: user.v1.GetProfileResponse
-	(*UpdateProfileRequest)(nil),             // 18: user.v1.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),            // 19: user.v1.UpdateProfileResponse
-	(*ChangePasswordRequest)(nil),            // 20: user.v1.ChangePasswordRequest
-	(*ChangePasswordResponse)(nil),           // 21: user.v1.ChangePasswordResponse
-	(*UpdateAvatarRequest)(nil),              // 22: user.v1.UpdateAvatarRequest
-	(*UpdateAvatarResponse)(nil),             // 23: user.v1.UpdateAvatarResponse
-	(*SearchUsersRequest)(nil),               // 24: user.v1.SearchUsersRequest
-	(*SearchUsersResponse)(nil),              // 25: user.v1.SearchUsersResponse
+	(*SocialIdentity)(nil),                   // 16: user.v1.SocialIdentity
+	(*LinkSocialAccountRequest)(nil),         // 17: user.v1.LinkSocialAccountRequest
+	(*LinkSocialAccountResponse)(nil),        // 18: user.v1.LinkSocialAccountResponse
+	(*UnlinkSocialAccountRequest)(nil),       // 19: user.v1.UnlinkSocialAccountRequest
+	(*UnlinkSocialAccountResponse)(nil),      // 20: user.v1.UnlinkSocialAccountResponse
+	(*ListSocialAccountsRequest)(nil),        // 21: user.v1.ListSocialAccountsRequest
+	(*ListSocialAccountsResponse)(nil),       // 22: user.v1.ListSocialAccountsResponse
+	(*RefreshTokenRequest)(nil),              // 23: user.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),             // 24: user.v1.RefreshTokenResponse
+	(*LogoutRequest)(nil),                    // 25: user.v1.LogoutRequest
+	(*LogoutResponse)(nil),                   // 26: user.v1.LogoutResponse
+	(*LogoutAllDevicesRequest)(nil),          // 27: user.v1.LogoutAllDevicesRequest
+	(*LogoutAllDevicesResponse)(nil),         // 28: user.v1.LogoutAllDevicesResponse
+	(*GetProfileRequest)(nil),                // 29: user.v1.GetProfileRequest
+	(*GetProfileResponse)(nil),               // 30: user.v1.GetProfileResponse
+	(*UpdateProfileRequest)(nil),             // 31: user.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),            // 32: user.v1.UpdateProfileResponse
+	(*ChangePasswordRequest)(nil),            // 33: user.v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),           // 34: user.v1.ChangePasswordResponse
+	(*UpdateAvatarRequest)(nil),              // 35: user.v1.UpdateAvatarRequest
+	(*UpdateAvatarResponse)(nil),             // 36: user.v1.UpdateAvatarResponse
+	(*SearchUsersRequest)(nil),               // 37: user.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),              // 38: user.v1.SearchUsersResponse
 }
 var file_user_proto_depIdxs = []int32{
 	1,  // 0: user.v1.SignUpResponse.user:type_name -> user.v1.User
 	1,  // 1: user.v1.LoginResponse.user:type_name -> user.v1.User
 	0,  // 2: user.v1.SocialLoginRequest.provider:type_name -> user.v1.SocialProvider
 	1,  // 3: user.v1.SocialLoginResponse.user:type_name -> user.v1.User
-	1,  // 4: user.v1.GetProfileResponse.user:type_name -> user.v1.User
-	1,  // 5: user.v1.UpdateProfileResponse.user:type_name -> user.v1.User
-	1,  // 6: user.v1.UpdateAvatarResponse.user:type_name -> user.v1.User
-	1,  // 7: user.v1.SearchUsersResponse.users:type_name -> user.v1.User
-	2,  // 8: user.v1.UserService.CheckUsername:input_type -> user.v1.CheckUsernameRequest
-	4,  // 9: user.v1.UserService.CheckEmail:input_type -> user.v1.CheckEmailRequest
-	6,  // 10: user.v1.UserService.RequestPhoneVerification:input_type -> user.v1.RequestPhoneVerificationRequest
-	8,  // 11: user.v1.UserService.VerifyPhone:input_type -> user.v1.VerifyPhoneRequest
-	10, // 12: user.v1.UserService.SignUp:input_type -> user.v1.SignUpRequest
-	12, // 13: user.v1.UserService.Login:input_type -> user.v1.LoginRequest
-	14, // 14: user.v1.UserService.SocialLogin:input_type -> user.v1.SocialLoginRequest
-	16, // 15: user.v1.UserService.GetProfile:input_type -> user.v1.GetProfileRequest
-	18, // 16: user.v1.UserService.UpdateProfile:input_type -> user.v1.UpdateProfileRequest
-	20, // 17: user.v1.UserService.ChangePassword:input_type -> user.v1.ChangePasswordRequest
-	22, // 18: user.v1.UserService.UpdateAvatar:input_type -> user.v1.UpdateAvatarRequest
-	24, // 19: user.v1.UserService.SearchUsers:input_type -> user.v1.SearchUsersRequest
-	3,  // 20: user.v1.UserService.CheckUsername:output_type -> user.v1.CheckUsernameResponse
-	5,  // 21: user.v1.UserService.CheckEmail:output_type -> user.v1.CheckEmailResponse
-	7,  // 22: user.v1.UserService.RequestPhoneVerification:output_type -> user.v1.RequestPhoneVerificationResponse
-	9,  // 23: user.v1.UserService.VerifyPhone:output_type -> user.v1.VerifyPhoneResponse
-	11, // 24: user.v1.UserService.SignUp:output_type -> user.v1.SignUpResponse
-	13, // 25: user.v1.UserService.Login:output_type -> user.v1.LoginResponse
-	15, // 26: user.v1.UserService.SocialLogin:output_type -> user.v1.SocialLoginResponse
-	17, // 27: user.v1.UserService.GetProfile:output_type -> user.v1.GetProfileResponse
-	19, // 28: user.v1.UserService.UpdateProfile:output_type -> user.v1.UpdateProfileResponse
-	21, // 29: user.v1.UserService.ChangePassword:output_type -> user.v1.ChangePasswordResponse
-	23, // 30: user.v1.UserService.UpdateAvatar:output_type -> user.v1.UpdateAvatarResponse
-	25, // 31: user.v1.UserService.SearchUsers:output_type -> user.v1.SearchUsersResponse
-	20, // [20:32] is the sub-list for method output_type
-	8,  // [8:20] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	0,  // 4: user.v1.SocialIdentity.provider:type_name -> user.v1.SocialProvider
+	0,  // 5: user.v1.LinkSocialAccountRequest.provider:type_name -> user.v1.SocialProvider
+	16, // 6: user.v1.LinkSocialAccountResponse.identities:type_name -> user.v1.SocialIdentity
+	0,  // 7: user.v1.UnlinkSocialAccountRequest.provider:type_name -> user.v1.SocialProvider
+	16, // 8: user.v1.UnlinkSocialAccountResponse.identities:type_name -> user.v1.SocialIdentity
+	16, // 9: user.v1.ListSocialAccountsResponse.identities:type_name -> user.v1.SocialIdentity
+	1,  // 10: user.v1.GetProfileResponse.user:type_name -> user.v1.User
+	1,  // 11: user.v1.UpdateProfileResponse.user:type_name -> user.v1.User
+	1,  // 12: user.v1.UpdateAvatarResponse.user:type_name -> user.v1.User
+	1,  // 13: user.v1.SearchUsersResponse.users:type_name -> user.v1.User
+	2,  // 14: user.v1.UserService.CheckUsername:input_type -> user.v1.CheckUsernameRequest
+	4,  // 15: user.v1.UserService.CheckEmail:input_type -> user.v1.CheckEmailRequest
+	6,  // 16: user.v1.UserService.RequestPhoneVerification:input_type -> user.v1.RequestPhoneVerificationRequest
+	8,  // 17: user.v1.UserService.VerifyPhone:input_type -> user.v1.VerifyPhoneRequest
+	10, // 18: user.v1.UserService.SignUp:input_type -> user.v1.SignUpRequest
+	12, // 19: user.v1.UserService.Login:input_type -> user.v1.LoginRequest
+	14, // 20: user.v1.UserService.SocialLogin:input_type -> user.v1.SocialLoginRequest
+	17, // 21: user.v1.UserService.LinkSocialAccount:input_type -> user.v1.LinkSocialAccountRequest
+	19, // 22: user.v1.UserService.UnlinkSocialAccount:input_type -> user.v1.UnlinkSocialAccountRequest
+	21, // 23: user.v1.UserService.ListSocialAccounts:input_type -> user.v1.ListSocialAccountsRequest
+	23, // 24: user.v1.UserService.RefreshToken:input_type -> user.v1.RefreshTokenRequest
+	25, // 25: user.v1.UserService.Logout:input_type -> user.v1.LogoutRequest
+	27, // 26: user.v1.UserService.LogoutAllDevices:input_type -> user.v1.LogoutAllDevicesRequest
+	29, // 27: user.v1.UserService.GetProfile:input_type -> user.v1.GetProfileRequest
+	31, // 28: user.v1.UserService.UpdateProfile:input_type -> user.v1.UpdateProfileRequest
+	33, // 29: user.v1.UserService.ChangePassword:input_type -> user.v1.ChangePasswordRequest
+	35, // 30: user.v1.UserService.UpdateAvatar:input_type -> user.v1.UpdateAvatarRequest
+	37, // 31: user.v1.UserService.SearchUsers:input_type -> user.v1.SearchUsersRequest
+	3,  // 32: user.v1.UserService.CheckUsername:output_type -> user.v1.CheckUsernameResponse
+	5,  // 33: user.v1.UserService.CheckEmail:output_type -> user.v1.CheckEmailResponse
+	7,  // 34: user.v1.UserService.RequestPhoneVerification:output_type -> user.v1.RequestPhoneVerificationResponse
+	9,  // 35: user.v1.UserService.VerifyPhone:output_type -> user.v1.VerifyPhoneResponse
+	11, // 36: user.v1.UserService.SignUp:output_type -> user.v1.SignUpResponse
+	13, // 37: user.v1.UserService.Login:output_type -> user.v1.LoginResponse
+	15, // 38: user.v1.UserService.SocialLogin:output_type -> user.v1.SocialLoginResponse
+	18, // 39: user.v1.UserService.LinkSocialAccount:output_type -> user.v1.LinkSocialAccountResponse
+	20, // 40: user.v1.UserService.UnlinkSocialAccount:output_type -> user.v1.UnlinkSocialAccountResponse
+	22, // 41: user.v1.UserService.ListSocialAccounts:output_type -> user.v1.ListSocialAccountsResponse
+	24, // 42: user.v1.UserService.RefreshToken:output_type -> user.v1.RefreshTokenResponse
+	26, // 43: user.v1.UserService.Logout:output_type -> user.v1.LogoutResponse
+	28, // 44: user.v1.UserService.LogoutAllDevices:output_type -> user.v1.LogoutAllDevicesResponse
+	30, // 45: user.v1.UserService.GetProfile:output_type -> user.v1.GetProfileResponse
+	32, // 46: user.v1.UserService.UpdateProfile:output_type -> user.v1.UpdateProfileResponse
+	34, // 47: user.v1.UserService.ChangePassword:output_type -> user.v1.ChangePasswordResponse
+	36, // 48: user.v1.UserService.UpdateAvatar:output_type -> user.v1.UpdateAvatarResponse
+	38, // 49: user.v1.UserService.SearchUsers:output_type -> user.v1.SearchUsersResponse
+	32, // [32:50] is the sub-list for method output_type
+	14, // [14:32] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -1565,7 +2241,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   25,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},